@@ -0,0 +1,84 @@
+// Command clob-signer is a local signing daemon for the CLOB client
+//
+// It holds the private key and signs ClobAuth messages and orders for clients connecting over
+// a Unix socket or a loopback HTTP address, enforcing a policy on every request and recording
+// it in an audit log. ClobAuth messages are only signed when the policy sets allow_clob_auth.
+// Clients use it through remotesigner.NewSigner.
+//
+// Usage:
+//
+//	PK=0x... CLOB_SIGNER_TOKEN=... clob-signer -policy policy.json -listen unix:///run/clob-signer.sock
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/pooofdevelopment/go-clob-client/pkg/remotesigner"
+	"github.com/pooofdevelopment/go-clob-client/pkg/signer"
+)
+
+func main() {
+	listen := flag.String("listen", "unix://clob-signer.sock", "Unix socket (unix:///path) or loopback address (127.0.0.1:8790) to listen on")
+	chainID := flag.Int("chain-id", 137, "chain ID")
+	policyPath := flag.String("policy", "", "policy file (JSON)")
+	auditPath := flag.String("audit", "clob-signer-audit.log", "audit log file (JSON lines)")
+	flag.Parse()
+
+	if *policyPath == "" {
+		log.Fatal("-policy is required")
+	}
+
+	// The key and token are read from the environment so they do not show up in the process list
+	privateKey := os.Getenv("PK")
+	if privateKey == "" {
+		log.Fatal("PK environment variable is required")
+	}
+	token := os.Getenv("CLOB_SIGNER_TOKEN")
+
+	s, err := signer.NewSigner(privateKey, *chainID)
+	if err != nil {
+		log.Fatalf("Failed to create signer: %v", err)
+	}
+
+	policy, err := remotesigner.LoadPolicy(*policyPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	audit, err := remotesigner.OpenAuditLog(*auditPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer audit.Close()
+
+	server, err := remotesigner.NewServer(s, policy, audit, token)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	listener, err := remotesigner.Listen(*listen)
+	if err != nil {
+		log.Fatalf("Failed to listen on %s: %v", *listen, err)
+	}
+
+	httpServer := &http.Server{Handler: server}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	go func() {
+		<-ctx.Done()
+		_ = httpServer.Shutdown(context.Background())
+	}()
+
+	log.Printf("Signing for %s on chain %d, listening on %s", s.Address(), *chainID, *listen)
+	if err := httpServer.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		log.Fatal(err)
+	}
+}
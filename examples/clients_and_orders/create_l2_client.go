@@ -68,8 +68,8 @@ func main() {
 	}
 
 	fmt.Printf("   ✓ API Key: %s...\n", creds.ApiKey[:8])
-	fmt.Printf("   ✓ API Secret: %s...\n", creds.ApiSecret[:8])
-	fmt.Printf("   ✓ API Passphrase: %s...\n", creds.ApiPassphrase[:8])
+	fmt.Printf("   ✓ API Secret: %s\n", creds.ApiSecret)
+	fmt.Printf("   ✓ API Passphrase: %s\n", creds.ApiPassphrase)
 
 	// Step 3: Upgrade to Level 2 by setting credentials
 	// Based on: py-clob-client-main/examples/create_api_key.py:19-20
@@ -18,6 +18,7 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/pooofdevelopment/go-clob-client/pkg/client"
+	"github.com/pooofdevelopment/go-clob-client/pkg/orderbook"
 	"github.com/pooofdevelopment/go-clob-client/pkg/types"
 	"github.com/pooofdevelopment/go-clob-client/pkg/websocket"
 )
@@ -64,7 +65,8 @@ type errorMsg error
 type MarketData struct {
 	tokenID    string
 	name       string
-	orderBook  *websocket.OrderBookUpdate
+	book       *orderbook.OrderBook       // Maintained from snapshots and price changes
+	orderBook  *websocket.OrderBookUpdate // Rendered view of book
 	spread     string
 	lastUpdate time.Time
 }
@@ -105,18 +107,12 @@ type state_model struct {
 }
 
 // WebSocket handler that sends messages to the tea program
+// Connection events are handled in the program loop, the embedded BaseHandler ignores them
 type wsHandler struct {
+	websocket.BaseHandler
 	program *tea.Program
 }
 
-func (h *wsHandler) OnConnect() {
-	// Connection handled in the program loop
-}
-
-func (h *wsHandler) OnDisconnect() {
-	// Disconnection handled in the program loop
-}
-
 func (h *wsHandler) OnError(err error) {
 	h.program.Send(errorMsg(err))
 }
@@ -133,10 +129,6 @@ func (h *wsHandler) OnTickSizeChange(update *websocket.TickSizeChangeUpdate) {
 	h.program.Send(tickSizeChangeMsg(update))
 }
 
-func (h *wsHandler) OnLastTradePrice(update *websocket.LastTradePriceUpdate) {
-	// Not needed for orderbook UI, but required by interface
-}
-
 func (h *wsHandler) OnUserUpdate(update *websocket.UserUpdate) {
 	h.program.Send(userUpdateMsg(update))
 }
@@ -180,14 +172,11 @@ func (m state_model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Update the correct market based on token ID
 		for i := range m.markets {
 			if m.markets[i].tokenID == msg.tokenID {
-				m.markets[i].orderBook = msg.update
-				m.markets[i].lastUpdate = time.Now()
-				// Update legacy fields for compatibility
-				if i == 0 {
-					m.yesBook = msg.update
-				} else if i == 1 {
-					m.noBook = msg.update
+				if err := m.markets[i].book.ApplySnapshot(msg.update); err != nil {
+					m.lastError = err
+					break
 				}
+				m.refreshBook(i)
 				break
 			}
 		}
@@ -199,26 +188,23 @@ func (m state_model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case priceChangeMsg:
 		m.updateCounts.priceChange++
 		m.updateCounts.total++
-		// Find the correct market based on token ID
-		var targetMarket *MarketData
+		// Apply price changes to the book of the market; the book resyncs on its own
+		// when it misses a snapshot
 		for i := range m.markets {
 			if m.markets[i].tokenID == msg.tokenID {
-				targetMarket = &m.markets[i]
+				if err := m.markets[i].book.ApplyPriceChange(msg.update); err != nil {
+					m.lastError = err
+					break
+				}
+				for _, change := range msg.update.Changes {
+					m.priceChanges[change.Price] = time.Now()
+				}
+				m.refreshBook(i)
+				m.lastUpdate = time.Now()
 				break
 			}
 		}
 
-		if targetMarket != nil && targetMarket.orderBook != nil {
-			// Apply price changes
-			for _, change := range msg.update.Changes {
-				m.applyPriceChangeToBook(targetMarket.orderBook, change)
-				m.priceChanges[change.Price] = time.Now()
-			}
-			targetMarket.orderBook.Hash = msg.update.Hash
-			targetMarket.orderBook.Timestamp = msg.update.Timestamp
-			m.lastUpdate = time.Now()
-		}
-
 	case errorMsg:
 		m.lastError = error(msg)
 		m.connected = false
@@ -226,6 +212,11 @@ func (m state_model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.updateCounts.total++
 
 	case tickSizeChangeMsg:
+		for i := range m.markets {
+			if m.markets[i].tokenID == msg.AssetID {
+				_ = m.markets[i].book.ApplyTickSizeChange(msg)
+			}
+		}
 		m.updateCounts.tickSize++
 		m.updateCounts.total++
 
@@ -634,38 +625,25 @@ func (m state_model) renderOrderSide(orders []types.OrderSummary, isBuys bool, r
 	return b.String()
 }
 
-func (m *state_model) applyPriceChangeToBook(book *websocket.OrderBookUpdate, change websocket.PriceChange) {
-	if book == nil {
-		return
-	}
-
-	// Apply change to the appropriate side
-	if change.Side == "BUY" {
-		m.updateOrderSide(&book.Buys, change.Price, change.Size)
-	} else if change.Side == "SELL" {
-		m.updateOrderSide(&book.Sells, change.Price, change.Size)
-	}
-}
-
-func (m *state_model) updateOrderSide(orders *[]types.OrderSummary, price, size string) {
-	// Find existing price level
-	for i, order := range *orders {
-		if order.Price == price {
-			if size == "0" {
-				// Remove the price level
-				*orders = append((*orders)[:i], (*orders)[i+1:]...)
-			} else {
-				// Update the size
-				(*orders)[i].Size = size
-			}
-			return
-		}
+// refreshBook renders the maintained book of a market into its view
+func (m *state_model) refreshBook(i int) {
+	summary := m.markets[i].book.Summary()
+	view := &websocket.OrderBookUpdate{
+		AssetID:   summary.AssetID,
+		Market:    summary.Market,
+		Timestamp: summary.Timestamp,
+		Hash:      summary.Hash,
+		Buys:      summary.Bids,
+		Sells:     summary.Asks,
 	}
 
-	// If price level doesn't exist and size > 0, add it
-	if size != "0" {
-		newOrder := types.OrderSummary{Price: price, Size: size}
-		*orders = append(*orders, newOrder)
+	m.markets[i].orderBook = view
+	m.markets[i].lastUpdate = time.Now()
+	// Update legacy fields for compatibility
+	if i == 0 {
+		m.yesBook = view
+	} else if i == 1 {
+		m.noBook = view
 	}
 }
 
@@ -763,6 +741,19 @@ func main() {
 		eventName = marketInfo.Question
 	}
 
+	// Keep a local order book per token, resynced from the REST API when needed
+	for i := range markets {
+		book, err := orderbook.NewOrderBook(orderbook.Config{
+			TokenID:  markets[i].tokenID,
+			TickSize: types.TickSize("0.01"),
+			Source:   clobClient,
+		})
+		if err != nil {
+			log.Fatalf("Failed to create order book: %v", err)
+		}
+		markets[i].book = book
+	}
+
 	// Create the tea program with client and markets
 	initialModelWithClient := func() state_model {
 		m := initialModel()
@@ -957,15 +948,15 @@ func lookupEventBySlug(client *client.ClobClient, slug string) ([]types.GammaMar
 	return markets, nil
 }
 
-// lookupMarketBySlug finds a market by slug and returns token IDs
-func lookupMarketBySlug(client *client.ClobClient, slug string) (*types.GammaMarket, string, string, error) {
+// lookupMarketBySlug finds a market by slug and returns its YES and NO token IDs
+func lookupMarketBySlug(clobClient *client.ClobClient, slug string) (*types.GammaMarket, string, string, error) {
 	// Search for the market by slug
 	params := &types.GammaMarketsParams{
 		Slug:  []string{slug},
 		Limit: 1,
 	}
 
-	markets, err := client.GetGammaMarkets(params)
+	markets, err := clobClient.GetGammaMarkets(params)
 	if err != nil {
 		return nil, "", "", fmt.Errorf("failed to search markets: %w", err)
 	}
@@ -976,14 +967,22 @@ func lookupMarketBySlug(client *client.ClobClient, slug string) (*types.GammaMar
 
 	market := markets[0]
 
-	if len(market.ClobTokenIDs) < 2 {
+	// Resolve the outcomes of the tokens, preferring the CLOB labels over the Gamma order
+	registry := client.NewMarketRegistry(clobClient)
+	registry.AddGammaMarkets(markets)
+	if _, err := registry.LoadMarket(market.ConditionID); err != nil {
+		log.Printf("Failed to load market %s from the CLOB, using Gamma outcomes: %v", market.ConditionID, err)
+	}
+
+	info, ok := registry.Market(market.ConditionID)
+	if !ok || len(info.Tokens) != 2 {
 		return nil, "", "", fmt.Errorf("market '%s' does not have both YES and NO tokens", slug)
 	}
 
-	// Typically the first token is YES and second is NO, but this can vary
-	// For safety, we'll just use them in order
-	yesTokenID := market.ClobTokenIDs[0]
-	noTokenID := market.ClobTokenIDs[1]
+	yesTokenID, noTokenID := info.Tokens[0].TokenID, info.Tokens[1].TokenID
+	if strings.EqualFold(info.Tokens[0].Outcome, "no") {
+		yesTokenID, noTokenID = noTokenID, yesTokenID
+	}
 
 	return &market, yesTokenID, noTokenID, nil
 }
@@ -0,0 +1,305 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+
+	"github.com/polymarket/go-order-utils/pkg/model"
+	"github.com/pooofdevelopment/go-clob-client/pkg/errors"
+	"github.com/pooofdevelopment/go-clob-client/pkg/httpclient"
+	"github.com/pooofdevelopment/go-clob-client/pkg/types"
+	"github.com/pooofdevelopment/go-clob-client/pkg/websocket"
+)
+
+// defaultAccountConcurrency is the default number of accounts processed in parallel by fan-out operations
+const defaultAccountConcurrency = 8
+
+// AccountManager holds many accounts (signer + ApiCreds) against one CLOB host
+// All accounts share one transport, rate limiter and market metadata cache, and order
+// calls are routed to the credentials of the account they are made for.
+// Each account client gets its own copy of the shared transport settings: SetHTTPClient,
+// WithRateLimiter or WithLogger on one account's ClobClient only affect that account.
+type AccountManager struct {
+	host        string
+	chainID     int
+	httpClient  *httpclient.Client
+	cache       *MarketCache
+	concurrency int
+
+	mu       sync.RWMutex
+	accounts map[string]*ClobClient
+	public   *ClobClient // L0 client used for market data
+}
+
+// AccountManagerOption is a functional option for configuring the AccountManager
+type AccountManagerOption func(*AccountManager)
+
+// WithSharedHTTPClient returns an AccountManagerOption that sets the HTTP client shared by all accounts
+func WithSharedHTTPClient(httpClient *http.Client) AccountManagerOption {
+	return func(m *AccountManager) {
+		m.httpClient.SetHTTPClient(httpClient)
+	}
+}
+
+// WithSharedRateLimit returns an AccountManagerOption that limits the combined request rate of all accounts
+func WithSharedRateLimit(requestsPerSecond float64, burst int) AccountManagerOption {
+	return func(m *AccountManager) {
+		m.httpClient.SetRateLimiter(httpclient.NewRateLimiter(requestsPerSecond, burst))
+	}
+}
+
+// WithConcurrency returns an AccountManagerOption that sets how many accounts fan-out operations process in parallel
+func WithConcurrency(n int) AccountManagerOption {
+	return func(m *AccountManager) {
+		if n > 0 {
+			m.concurrency = n
+		}
+	}
+}
+
+// NewAccountManager creates a new account manager
+func NewAccountManager(host string, chainID int, opts ...AccountManagerOption) (*AccountManager, error) {
+	m := &AccountManager{
+		host:        host,
+		chainID:     chainID,
+		httpClient:  httpclient.NewClient(),
+		cache:       NewMarketCache(),
+		concurrency: defaultAccountConcurrency,
+		accounts:    make(map[string]*ClobClient),
+	}
+
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	public, err := NewClobClientWithOptions(host, chainID, "", nil, nil, nil, m.sharedOptions()...)
+	if err != nil {
+		return nil, err
+	}
+	m.public = public
+
+	return m, nil
+}
+
+// sharedOptions returns the options wiring a new client to the shared transport and cache
+// The client gets its own wrapper around the shared HTTP client and rate limiter, so that
+// per-account settings do not leak to the other accounts
+func (m *AccountManager) sharedOptions() []ClientOption {
+	return []ClientOption{withTransport(m.httpClient.Clone()), WithMarketCache(m.cache)}
+}
+
+// AddAccount creates a client for the given account and registers it under id
+// An existing account with the same id is replaced
+func (m *AccountManager) AddAccount(id string, privateKey string, creds *types.ApiCreds, signatureType *model.SignatureType, funder *string) (*ClobClient, error) {
+	if id == "" {
+		return nil, fmt.Errorf("account id is required")
+	}
+
+	c, err := NewClobClientWithOptions(m.host, m.chainID, privateKey, creds, signatureType, funder, m.sharedOptions()...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client for account %s: %w", id, err)
+	}
+
+	m.mu.Lock()
+	m.accounts[id] = c
+	m.mu.Unlock()
+
+	return c, nil
+}
+
+// RemoveAccount unregisters an account
+func (m *AccountManager) RemoveAccount(id string) {
+	m.mu.Lock()
+	delete(m.accounts, id)
+	m.mu.Unlock()
+}
+
+// Client returns the client registered for an account
+func (m *AccountManager) Client(id string) (*ClobClient, error) {
+	m.mu.RLock()
+	c, ok := m.accounts[id]
+	m.mu.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", errors.ErrAccountNotFound, id)
+	}
+	return c, nil
+}
+
+// Accounts returns the ids of all registered accounts in sorted order
+func (m *AccountManager) Accounts() []string {
+	m.mu.RLock()
+	ids := make([]string, 0, len(m.accounts))
+	for id := range m.accounts {
+		ids = append(ids, id)
+	}
+	m.mu.RUnlock()
+
+	sort.Strings(ids)
+	return ids
+}
+
+// Public returns an unauthenticated client sharing the manager's transport and cache, for market data
+func (m *AccountManager) Public() *ClobClient {
+	return m.public
+}
+
+// CreateOrder creates and signs an order for an account
+func (m *AccountManager) CreateOrder(id string, orderArgs *types.OrderArgs, options *types.PartialCreateOrderOptions) (*model.SignedOrder, error) {
+	c, err := m.Client(id)
+	if err != nil {
+		return nil, err
+	}
+	return c.CreateOrder(orderArgs, options)
+}
+
+// PostOrder posts an order with the credentials of an account
+func (m *AccountManager) PostOrder(id string, order *model.SignedOrder, orderType types.OrderType) (map[string]interface{}, error) {
+	c, err := m.Client(id)
+	if err != nil {
+		return nil, err
+	}
+	return c.PostOrder(order, orderType)
+}
+
+// CreateAndPostOrder creates, signs and posts an order for an account
+func (m *AccountManager) CreateAndPostOrder(id string, orderArgs *types.OrderArgs, options *types.PartialCreateOrderOptions) (map[string]interface{}, error) {
+	c, err := m.Client(id)
+	if err != nil {
+		return nil, err
+	}
+	return c.CreateAndPostOrder(orderArgs, options)
+}
+
+// Cancel cancels an order of an account
+func (m *AccountManager) Cancel(id string, orderID string) (map[string]interface{}, error) {
+	c, err := m.Client(id)
+	if err != nil {
+		return nil, err
+	}
+	return c.Cancel(orderID)
+}
+
+// AccountResult holds the outcome of an account-wide operation for a single account
+type AccountResult struct {
+	Account  string
+	Response map[string]interface{}
+	Err      error
+}
+
+// AccountOrders holds the open orders fetched for a single account
+type AccountOrders struct {
+	Account string
+	Orders  []types.Order
+	Err     error
+}
+
+// ForEach runs fn concurrently for every registered account and returns the errors by account id
+// At most the configured concurrency of accounts are processed at the same time
+func (m *AccountManager) ForEach(fn func(id string, c *ClobClient) error) map[string]error {
+	m.mu.RLock()
+	clients := make(map[string]*ClobClient, len(m.accounts))
+	for id, c := range m.accounts {
+		clients[id] = c
+	}
+	m.mu.RUnlock()
+
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		errs   = make(map[string]error)
+		tokens = make(chan struct{}, m.concurrency)
+	)
+
+	for id, c := range clients {
+		wg.Add(1)
+		tokens <- struct{}{}
+		go func(id string, c *ClobClient) {
+			defer wg.Done()
+			defer func() { <-tokens }()
+
+			if err := fn(id, c); err != nil {
+				mu.Lock()
+				errs[id] = err
+				mu.Unlock()
+			}
+		}(id, c)
+	}
+	wg.Wait()
+
+	return errs
+}
+
+// CancelAll cancels all open orders of every account concurrently
+// Results are sorted by account id
+func (m *AccountManager) CancelAll() []AccountResult {
+	var mu sync.Mutex
+	responses := make(map[string]map[string]interface{})
+
+	errs := m.ForEach(func(id string, c *ClobClient) error {
+		resp, err := c.CancelAll()
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		responses[id] = resp
+		mu.Unlock()
+		return nil
+	})
+
+	results := make([]AccountResult, 0, len(responses)+len(errs))
+	for id, resp := range responses {
+		results = append(results, AccountResult{Account: id, Response: resp})
+	}
+	for id, err := range errs {
+		results = append(results, AccountResult{Account: id, Err: err})
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i].Account < results[j].Account })
+
+	return results
+}
+
+// GetOrders fetches the open orders of every account concurrently
+// Results are sorted by account id
+func (m *AccountManager) GetOrders(params *types.OpenOrderParams) []AccountOrders {
+	var mu sync.Mutex
+	orders := make(map[string][]types.Order)
+
+	errs := m.ForEach(func(id string, c *ClobClient) error {
+		o, err := c.GetOrders(params, "")
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		orders[id] = o
+		mu.Unlock()
+		return nil
+	})
+
+	results := make([]AccountOrders, 0, len(orders)+len(errs))
+	for id, o := range orders {
+		results = append(results, AccountOrders{Account: id, Orders: o})
+	}
+	for id, err := range errs {
+		results = append(results, AccountOrders{Account: id, Err: err})
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i].Account < results[j].Account })
+
+	return results
+}
+
+// SubscribeToMarketData opens one market data websocket shared by all accounts
+func (m *AccountManager) SubscribeToMarketData(tokenIDs []string, handler websocket.MessageHandler) (*websocket.Client, error) {
+	return m.public.SubscribeToMarketData(tokenIDs, handler)
+}
+
+// SubscribeToUserData opens a user channel websocket with the credentials of an account
+func (m *AccountManager) SubscribeToUserData(id string, markets []string, handler websocket.MessageHandler) (*websocket.Client, error) {
+	c, err := m.Client(id)
+	if err != nil {
+		return nil, err
+	}
+	return c.SubscribeToUserData(markets, handler)
+}
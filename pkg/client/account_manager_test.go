@@ -0,0 +1,166 @@
+package client_test
+
+import (
+	stderrors "errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pooofdevelopment/go-clob-client/pkg/client"
+	"github.com/pooofdevelopment/go-clob-client/pkg/errors"
+	"github.com/pooofdevelopment/go-clob-client/pkg/httpclient"
+	"github.com/pooofdevelopment/go-clob-client/pkg/types"
+)
+
+// TestAccountManager tests routing, fan-out and the shared market cache of the AccountManager
+func TestAccountManager(t *testing.T) {
+	var (
+		mu             sync.Mutex
+		cancelAllKeys  = make(map[string]bool)
+		tickSizeCalls  int32
+		unexpectedPath string
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case types.CANCEL_ALL:
+			mu.Lock()
+			cancelAllKeys[r.Header.Get("POLY_API_KEY")] = true
+			mu.Unlock()
+			_, _ = w.Write([]byte(`{"canceled":[],"not_canceled":{}}`))
+		case types.GET_TICK_SIZE:
+			atomic.AddInt32(&tickSizeCalls, 1)
+			_, _ = w.Write([]byte(`{"minimum_tick_size":0.01}`))
+		default:
+			mu.Lock()
+			unexpectedPath = r.URL.Path
+			mu.Unlock()
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	manager, err := client.NewAccountManager(server.URL, 137, client.WithConcurrency(2))
+	if err != nil {
+		t.Fatalf("NewAccountManager() failed: %v", err)
+	}
+
+	accounts := map[string]string{
+		"alice": "0xac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80",
+		"bob":   "0x59c6995e998f97a5a0044966f0945389dc9e86dae88c7a8412f4603b6b78690d",
+		"carol": "0xac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80",
+	}
+	for id, key := range accounts {
+		creds := &types.ApiCreds{
+			ApiKey:        "key-" + id,
+			ApiSecret:     "c2VjcmV0LXNlY3JldC1zZWNyZXQ=",
+			ApiPassphrase: types.Secret("passphrase-" + id),
+		}
+		if _, err := manager.AddAccount(id, key, creds, nil, nil); err != nil {
+			t.Fatalf("AddAccount(%s) failed: %v", id, err)
+		}
+	}
+
+	if got := manager.Accounts(); len(got) != 3 || got[0] != "alice" || got[2] != "carol" {
+		t.Errorf("Accounts() = %v, want sorted ids", got)
+	}
+
+	results := manager.CancelAll()
+	if len(results) != 3 {
+		t.Fatalf("CancelAll() returned %d results, want 3", len(results))
+	}
+	for _, result := range results {
+		if result.Err != nil {
+			t.Errorf("CancelAll() for %s failed: %v", result.Account, result.Err)
+		}
+		if !cancelAllKeys["key-"+result.Account] {
+			t.Errorf("CancelAll() for %s was not sent with its own API key", result.Account)
+		}
+	}
+
+	// Tick sizes resolved by one account are served from the shared cache for the others
+	for id := range accounts {
+		c, err := manager.Client(id)
+		if err != nil {
+			t.Fatalf("Client(%s) failed: %v", id, err)
+		}
+		if _, err := c.GetTickSize("123"); err != nil {
+			t.Fatalf("GetTickSize() failed: %v", err)
+		}
+	}
+	if calls := atomic.LoadInt32(&tickSizeCalls); calls != 1 {
+		t.Errorf("tick size endpoint called %d times, want 1", calls)
+	}
+
+	manager.RemoveAccount("bob")
+	if _, err := manager.Cancel("bob", "order-id"); !stderrors.Is(err, errors.ErrAccountNotFound) {
+		t.Errorf("Cancel() for removed account error = %v, want ErrAccountNotFound", err)
+	}
+
+	if unexpectedPath != "" {
+		t.Errorf("unexpected request to %s", unexpectedPath)
+	}
+}
+
+// roundTripFunc is an http.RoundTripper calling a function
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) { return f(r) }
+
+// TestAccountManagerIsolation tests that transport settings of one account do not leak to the others
+func TestAccountManagerIsolation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`"OK"`))
+	}))
+	defer server.Close()
+
+	manager, err := client.NewAccountManager(server.URL, 137, client.WithSharedRateLimit(1000, 10))
+	if err != nil {
+		t.Fatalf("NewAccountManager() failed: %v", err)
+	}
+	alice, _ := manager.AddAccount("alice", "0xac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80", nil, nil, nil)
+	bob, _ := manager.AddAccount("bob", "0x59c6995e998f97a5a0044966f0945389dc9e86dae88c7a8412f4603b6b78690d", nil, nil, nil)
+
+	var custom int32
+	alice.SetHTTPClient(&http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		atomic.AddInt32(&custom, 1)
+		return http.DefaultTransport.RoundTrip(r)
+	})})
+
+	for _, c := range []*client.ClobClient{bob, manager.Public(), alice} {
+		if _, err := c.GetOk(); err != nil {
+			t.Fatalf("GetOk() failed: %v", err)
+		}
+	}
+	if calls := atomic.LoadInt32(&custom); calls != 1 {
+		t.Errorf("custom transport used by %d requests, want only alice's", calls)
+	}
+}
+
+// TestWithRateLimiterOrder tests that WithHTTPClient keeps a rate limiter set before it
+func TestWithRateLimiterOrder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`"OK"`))
+	}))
+	defer server.Close()
+
+	c, err := client.NewClobClientWithOptions(server.URL, 137, "", nil, nil, nil,
+		client.WithRateLimiter(httpclient.NewRateLimiter(10, 1)),
+		client.WithHTTPClient(server.Client()))
+	if err != nil {
+		t.Fatalf("NewClobClientWithOptions() failed: %v", err)
+	}
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if _, err := c.GetOk(); err != nil {
+			t.Fatalf("GetOk() failed: %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 150*time.Millisecond {
+		t.Errorf("3 requests at 10/s took %s, want the rate limiter applied", elapsed)
+	}
+}
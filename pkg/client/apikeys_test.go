@@ -0,0 +1,193 @@
+package client_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pooofdevelopment/go-clob-client/pkg/client"
+	"github.com/pooofdevelopment/go-clob-client/pkg/types"
+)
+
+// fakeKeyServer is a minimal stand-in for the CLOB auth endpoints
+type fakeKeyServer struct {
+	mu     sync.Mutex
+	keys   map[string]bool
+	outage bool // Fail authenticated calls with a 503
+
+	onCreate func() // Called while creating a key
+	lastKey  string // API key of the last authenticated call
+}
+
+func (f *fakeKeyServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	apiKey := r.Header.Get("POLY_API_KEY")
+	if apiKey != "" {
+		f.lastKey = apiKey
+	}
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == types.CREATE_API_KEY:
+		if f.onCreate != nil {
+			f.onCreate()
+		}
+		key := "key-" + r.Header.Get("POLY_NONCE")
+		f.keys[key] = true
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"apiKey":     key,
+			"secret":     "c2VjcmV0LXNlY3JldC1zZWNyZXQ=",
+			"passphrase": "passphrase",
+		})
+	case r.Method == http.MethodGet && r.URL.Path == types.GET_API_KEYS:
+		if f.outage {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		if !f.keys[apiKey] {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error":"Unauthorized/Invalid api key"}`))
+			return
+		}
+		keys := []string{}
+		for key := range f.keys {
+			keys = append(keys, key)
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"apiKeys": keys})
+	case r.Method == http.MethodDelete && r.URL.Path == types.DELETE_API_KEY:
+		if !f.keys[apiKey] {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		delete(f.keys, apiKey)
+		_, _ = w.Write([]byte(`"OK"`))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// TestRotateApiKey tests creating, verifying, switching to and deleting API keys
+func TestRotateApiKey(t *testing.T) {
+	fake := &fakeKeyServer{keys: map[string]bool{"key-0": true}}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	oldCreds := &types.ApiCreds{
+		ApiKey:        "key-0",
+		ApiSecret:     "c2VjcmV0LXNlY3JldC1zZWNyZXQ=",
+		ApiPassphrase: "passphrase",
+	}
+	c, err := client.NewClobClient(server.URL, 137, "0xac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80", oldCreds, nil, nil)
+	if err != nil {
+		t.Fatalf("NewClobClient() failed: %v", err)
+	}
+
+	keys, err := c.ListApiKeys()
+	if err != nil {
+		t.Fatalf("ListApiKeys() failed: %v", err)
+	}
+	if len(keys) != 1 || keys[0] != "key-0" {
+		t.Errorf("ListApiKeys() = %v, want [key-0]", keys)
+	}
+
+	nonce := 7
+	newCreds, err := c.RotateApiKey(&nonce)
+	if err != nil {
+		t.Fatalf("RotateApiKey() failed: %v", err)
+	}
+	if newCreds.ApiKey != "key-7" {
+		t.Errorf("RotateApiKey() returned key %s, want key-7", newCreds.ApiKey)
+	}
+
+	// The client now authenticates with the new key and the old one is gone
+	keys, err = c.ListApiKeys()
+	if err != nil {
+		t.Fatalf("ListApiKeys() after rotation failed: %v", err)
+	}
+	if len(keys) != 1 || keys[0] != "key-7" {
+		t.Errorf("ListApiKeys() after rotation = %v, want [key-7]", keys)
+	}
+	if err := c.VerifyApiCreds(oldCreds); err == nil {
+		t.Error("VerifyApiCreds() should fail for the deleted key")
+	}
+}
+
+// TestRotateApiKeyConcurrentSet tests that credentials set during a rotation are not overwritten
+func TestRotateApiKeyConcurrentSet(t *testing.T) {
+	fake := &fakeKeyServer{keys: map[string]bool{"key-0": true, "key-other": true}}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	secret := types.Secret("c2VjcmV0LXNlY3JldC1zZWNyZXQ=")
+	oldCreds := &types.ApiCreds{ApiKey: "key-0", ApiSecret: secret, ApiPassphrase: "passphrase"}
+	otherCreds := &types.ApiCreds{ApiKey: "key-other", ApiSecret: secret, ApiPassphrase: "passphrase"}
+	c, err := client.NewClobClient(server.URL, 137, "0xac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80", oldCreds, nil, nil)
+	if err != nil {
+		t.Fatalf("NewClobClient() failed: %v", err)
+	}
+
+	// Set other credentials while the new key is being created
+	set := make(chan struct{})
+	fake.onCreate = func() {
+		go func() {
+			c.SetApiCreds(otherCreds)
+			close(set)
+		}()
+		select {
+		case <-set:
+			t.Error("SetApiCreds() returned during the rotation")
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+
+	nonce := 7
+	if _, err := c.RotateApiKey(&nonce); err != nil {
+		t.Fatalf("RotateApiKey() failed: %v", err)
+	}
+	<-set
+
+	// The credentials set last are kept
+	if _, err := c.ListApiKeys(); err != nil {
+		t.Fatalf("ListApiKeys() failed: %v", err)
+	}
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+	if fake.lastKey != "key-other" {
+		t.Errorf("client uses key %s after the rotation, want key-other", fake.lastKey)
+	}
+}
+
+// TestValidateReadonlyApiKey tests that rejected keys are reported without an error
+func TestValidateReadonlyApiKey(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("key") {
+		case "valid":
+			_, _ = w.Write([]byte(`"OK"`))
+		case "unknown":
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error":"invalid readonly api key"}`))
+		default:
+			w.WriteHeader(http.StatusBadGateway)
+		}
+	}))
+
+	c, _ := client.NewClobClient(server.URL, 137, "", nil, nil, nil)
+	address := "0xf39fd6e51aad88f6f4ce6ab8827279cfffb92266"
+	if valid, err := c.ValidateReadonlyApiKey(address, "valid"); !valid || err != nil {
+		t.Errorf("ValidateReadonlyApiKey(valid) = %v, %v, want true", valid, err)
+	}
+	if valid, err := c.ValidateReadonlyApiKey(address, "unknown"); valid || err != nil {
+		t.Errorf("ValidateReadonlyApiKey(unknown) = %v, %v, want false without error", valid, err)
+	}
+	if valid, err := c.ValidateReadonlyApiKey(address, "outage"); valid || err == nil {
+		t.Errorf("ValidateReadonlyApiKey() during an outage = %v, %v, want an error", valid, err)
+	}
+
+	server.Close()
+	if _, err := c.ValidateReadonlyApiKey(address, "valid"); err == nil {
+		t.Error("ValidateReadonlyApiKey() without a server should fail")
+	}
+}
@@ -0,0 +1,128 @@
+package client_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/pooofdevelopment/go-clob-client/pkg/client"
+	"github.com/pooofdevelopment/go-clob-client/pkg/headers"
+	"github.com/pooofdevelopment/go-clob-client/pkg/types"
+)
+
+var builderCreds = &types.ApiCreds{
+	ApiKey:        "builder-key",
+	ApiSecret:     "c2VjcmV0LXNlY3JldC1zZWNyZXQ=",
+	ApiPassphrase: "builder-passphrase",
+}
+
+// TestCreateBuilderHeaders tests builder header signatures against fixed vectors
+func TestCreateBuilderHeaders(t *testing.T) {
+	tests := []struct {
+		name        string
+		requestArgs *types.RequestArgs
+		want        string
+	}{
+		{
+			name: "with body",
+			requestArgs: &types.RequestArgs{
+				Method:      "DELETE",
+				RequestPath: types.CANCEL,
+				Body:        map[string]string{"orderID": "0xabc"},
+			},
+			want: "1IGmIy308vy6crMGPqAoxFjDn_t-nI_QFA_N0lXkQ80=",
+		},
+		{
+			name: "without body",
+			requestArgs: &types.RequestArgs{
+				Method:      "DELETE",
+				RequestPath: types.CANCEL_ALL,
+			},
+			want: "X0YlMbgL1tAQ9caAMppEJEhsC17703gdv0yJjh-6fUw=",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, err := headers.CreateBuilderHeaders(builderCreds, tt.requestArgs, 1700000000)
+			if err != nil {
+				t.Fatalf("CreateBuilderHeaders() failed: %v", err)
+			}
+			if h[headers.POLY_BUILDER_SIGNATURE] != tt.want {
+				t.Errorf("signature = %s, want %s", h[headers.POLY_BUILDER_SIGNATURE], tt.want)
+			}
+			if h[headers.POLY_BUILDER_API_KEY] != "builder-key" ||
+				h[headers.POLY_BUILDER_PASSPHRASE] != "builder-passphrase" ||
+				h[headers.POLY_BUILDER_TIMESTAMP] != "1700000000" {
+				t.Errorf("unexpected builder headers: %v", h)
+			}
+		})
+	}
+}
+
+// TestBuilderAttribution tests that order requests carry builder headers from a remote signer
+func TestBuilderAttribution(t *testing.T) {
+	// Remote signer backed by the local signer
+	remote := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var req struct {
+			Method    string `json:"method"`
+			Path      string `json:"path"`
+			Body      string `json:"body"`
+			Timestamp int64  `json:"timestamp"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		args := &types.RequestArgs{Method: req.Method, RequestPath: req.Path}
+		if req.Body != "" {
+			args.Body = json.RawMessage(req.Body)
+		}
+		h, err := headers.CreateBuilderHeaders(builderCreds, args, req.Timestamp)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(h)
+	}))
+	defer remote.Close()
+
+	var got http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Clone()
+		_, _ = w.Write([]byte(`{"canceled":["0xabc"]}`))
+	}))
+	defer server.Close()
+
+	creds := &types.ApiCreds{ApiKey: "key", ApiSecret: "c2VjcmV0LXNlY3JldC1zZWNyZXQ=", ApiPassphrase: "passphrase"}
+	c, err := client.NewClobClientWithOptions(server.URL, 137, "0xac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80", creds, nil, nil,
+		client.WithBuilderSigner(headers.NewRemoteBuilderSigner(remote.URL, "token")))
+	if err != nil {
+		t.Fatalf("NewClobClientWithOptions() failed: %v", err)
+	}
+
+	if _, err := c.Cancel("0xabc"); err != nil {
+		t.Fatalf("Cancel() failed: %v", err)
+	}
+
+	ts, err := strconv.ParseInt(got.Get(headers.POLY_BUILDER_TIMESTAMP), 10, 64)
+	if err != nil {
+		t.Fatalf("request has no valid builder timestamp: %v", err)
+	}
+	want, _ := headers.CreateBuilderHeaders(builderCreds, &types.RequestArgs{
+		Method:      "DELETE",
+		RequestPath: types.CANCEL,
+		Body:        map[string]string{"orderID": "0xabc"},
+	}, ts)
+	for k, v := range want {
+		if got.Get(k) != v {
+			t.Errorf("header %s = %q, want %q", k, got.Get(k), v)
+		}
+	}
+	if got.Get(headers.POLY_API_KEY) != "key" {
+		t.Errorf("Level 2 headers missing, POLY_API_KEY = %q", got.Get(headers.POLY_API_KEY))
+	}
+}
@@ -0,0 +1,54 @@
+package client
+
+import (
+	"sync"
+
+	"github.com/pooofdevelopment/go-clob-client/pkg/types"
+)
+
+// MarketCache caches per-token market metadata (tick size and neg risk flag)
+// It is safe for concurrent use and can be shared between several ClobClients
+// Based on: py-clob-client-main/py_clob_client/client.py:123-124
+type MarketCache struct {
+	mu        sync.RWMutex
+	tickSizes map[string]types.TickSize
+	negRisk   map[string]bool
+}
+
+// NewMarketCache creates an empty market cache
+func NewMarketCache() *MarketCache {
+	return &MarketCache{
+		tickSizes: make(map[string]types.TickSize),
+		negRisk:   make(map[string]bool),
+	}
+}
+
+// TickSize returns the cached tick size for a token
+func (m *MarketCache) TickSize(tokenID string) (types.TickSize, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	tickSize, ok := m.tickSizes[tokenID]
+	return tickSize, ok
+}
+
+// SetTickSize caches the tick size for a token
+func (m *MarketCache) SetTickSize(tokenID string, tickSize types.TickSize) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.tickSizes[tokenID] = tickSize
+}
+
+// NegRisk returns the cached neg risk flag for a token
+func (m *MarketCache) NegRisk(tokenID string) (bool, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	negRisk, ok := m.negRisk[tokenID]
+	return negRisk, ok
+}
+
+// SetNegRisk caches the neg risk flag for a token
+func (m *MarketCache) SetNegRisk(tokenID string, negRisk bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.negRisk[tokenID] = negRisk
+}
@@ -2,14 +2,19 @@ package client
 
 import (
 	"fmt"
+	"log/slog"
 	"net/http"
 	"strings"
+	"sync"
+	"sync/atomic"
 
 	"github.com/polymarket/go-order-utils/pkg/model"
 	"github.com/pooofdevelopment/go-clob-client/pkg/config"
+	"github.com/pooofdevelopment/go-clob-client/pkg/credstore"
 	"github.com/pooofdevelopment/go-clob-client/pkg/errors"
 	"github.com/pooofdevelopment/go-clob-client/pkg/headers"
 	"github.com/pooofdevelopment/go-clob-client/pkg/httpclient"
+	"github.com/pooofdevelopment/go-clob-client/pkg/logging"
 	"github.com/pooofdevelopment/go-clob-client/pkg/orderbuilder"
 	"github.com/pooofdevelopment/go-clob-client/pkg/signer"
 	"github.com/pooofdevelopment/go-clob-client/pkg/types"
@@ -18,20 +23,65 @@ import (
 )
 
 // ClobClient is the main client for interacting with the CLOB API
+// A ClobClient is safe for concurrent use. Credentials, signer and transport can be swapped
+// while requests are in flight; each request uses the credentials it was signed with.
 // Based on: py-clob-client-main/py_clob_client/client.py:89-127
 type ClobClient struct {
 	host       string
 	chainID    int
-	signer     *signer.Signer
-	creds      *types.ApiCreds
-	mode       int
-	builder    *orderbuilder.OrderBuilder
 	httpClient *httpclient.Client
 
-	// Local cache
+	// Signer, credentials and order builder, replaced as a whole by SetApiCreds and SetSigner
+	auth   atomic.Pointer[authState]
+	authMu sync.Mutex // Serializes writers of auth
+
+	// Local cache, shared between clients created by the same AccountManager
 	// Based on: py-clob-client-main/py_clob_client/client.py:123-124
-	tickSizes map[string]types.TickSize
-	negRisk   map[string]bool
+	cache *MarketCache
+
+	// Optional persistent credential store, see WithCredentialStore
+	credStore credstore.Store
+	credNonce *int
+
+	// Optional builder attribution for order requests, see WithBuilderSigner
+	builderSigner headers.BuilderSigner
+
+	// Structured logger, silent by default, see WithLogger
+	logger *slog.Logger
+
+	// Options of the websocket clients, see WithWebsocketOptions
+	wsOptions []websocket.ClientOption
+}
+
+// authState is an immutable snapshot of the client authentication
+type authState struct {
+	signer  *signer.Signer
+	creds   *types.ApiCreds
+	mode    int
+	builder *orderbuilder.OrderBuilder
+}
+
+// newAuthState builds an authentication snapshot and determines its client mode
+func newAuthState(s *signer.Signer, creds *types.ApiCreds, builder *orderbuilder.OrderBuilder) *authState {
+	auth := &authState{
+		signer:  s,
+		creds:   creds,
+		builder: builder,
+	}
+	auth.mode = auth.getClientMode()
+	return auth
+}
+
+// newOrderBuilder creates the order builder for a signer
+// Based on: py-clob-client-main/py_clob_client/client.py:117-120
+func newOrderBuilder(s *signer.Signer, signatureType *model.SignatureType, funder *string) *orderbuilder.OrderBuilder {
+	// Normalize funder address to lowercase if provided
+	var normalizedFunder *string
+	if funder != nil && *funder != "" {
+		lower := strings.ToLower(*funder)
+		normalizedFunder = &lower
+	}
+	return orderbuilder.NewOrderBuilder(s, signatureType, normalizedFunder)
 }
 
 // NewClobClient creates a new CLOB client
@@ -57,29 +107,22 @@ func NewClobClient(host string, chainID int, privateKey string, creds *types.Api
 	client := &ClobClient{
 		host:       host,
 		chainID:    chainID,
-		signer:     s,
-		creds:      creds,
 		httpClient: httpclient.NewClient(),
-		tickSizes:  make(map[string]types.TickSize),
-		negRisk:    make(map[string]bool),
+		cache:      NewMarketCache(),
+		logger:     logging.Discard(),
 	}
 
-	// Set client mode
-	// Based on: py-clob-client-main/py_clob_client/client.py:115
-	client.mode = client.getClientMode()
-
 	// Create order builder if signer is available
 	// Based on: py-clob-client-main/py_clob_client/client.py:117-120
+	var builder *orderbuilder.OrderBuilder
 	if s != nil {
-		// Normalize funder address to lowercase if provided
-		var normalizedFunder *string
-		if funder != nil && *funder != "" {
-			lower := strings.ToLower(*funder)
-			normalizedFunder = &lower
-		}
-		client.builder = orderbuilder.NewOrderBuilder(s, signatureType, normalizedFunder)
+		builder = newOrderBuilder(s, signatureType, funder)
 	}
 
+	// Set client mode
+	// Based on: py-clob-client-main/py_clob_client/client.py:115
+	client.auth.Store(newAuthState(s, creds, builder))
+
 	return client, nil
 }
 
@@ -87,9 +130,77 @@ func NewClobClient(host string, chainID int, privateKey string, creds *types.Api
 type ClientOption func(*ClobClient)
 
 // WithHTTPClient returns a ClientOption that sets a custom HTTP client
+// It keeps the rate limiter and logger of the other options, whatever their order. When its
+// transport is an *http.Transport, websocket connections use the same proxy, TLS
+// configuration and dialing, see websocket.DialerFromTransport.
 func WithHTTPClient(httpClient *http.Client) ClientOption {
 	return func(c *ClobClient) {
-		c.httpClient = httpclient.NewClientWithHTTPClient(httpClient)
+		c.httpClient.SetHTTPClient(httpClient)
+	}
+}
+
+// WithRateLimiter returns a ClientOption that rate limits all REST requests of the client
+func WithRateLimiter(limiter *httpclient.RateLimiter) ClientOption {
+	return func(c *ClobClient) {
+		c.httpClient.SetRateLimiter(limiter)
+	}
+}
+
+// WithMarketCache returns a ClientOption that uses a shared market metadata cache
+func WithMarketCache(cache *MarketCache) ClientOption {
+	return func(c *ClobClient) {
+		c.cache = cache
+	}
+}
+
+// WithCredentialStore returns a ClientOption that caches API credentials in a store
+// When the client is created without credentials, NewClobClientWithOptions loads them from the
+// store, validates them and only creates or derives new ones (with the given nonce) when needed.
+// Credentials obtained by RotateApiKey are persisted as well.
+func WithCredentialStore(store credstore.Store, nonce *int) ClientOption {
+	return func(c *ClobClient) {
+		c.credStore = store
+		c.credNonce = nonce
+	}
+}
+
+// WithBuilderSigner returns a ClientOption that adds builder attribution headers to order
+// requests (PostOrder, PostOrders and cancels)
+func WithBuilderSigner(builder headers.BuilderSigner) ClientOption {
+	return func(c *ClobClient) {
+		c.builderSigner = builder
+	}
+}
+
+// WithBuilderCreds returns a ClientOption that signs builder attribution headers locally
+// with the given builder API credentials
+func WithBuilderCreds(creds *types.ApiCreds) ClientOption {
+	return WithBuilderSigner(headers.NewLocalBuilderSigner(creds))
+}
+
+// WithLogger returns a ClientOption that sets the structured logger of the client, its HTTP
+// requests and the websocket clients it creates
+// Without a logger the client is silent.
+func WithLogger(logger *slog.Logger) ClientOption {
+	return func(c *ClobClient) {
+		c.logger = logging.OrDiscard(logger)
+		c.httpClient.SetLogger(logger)
+	}
+}
+
+// WithWebsocketOptions returns a ClientOption that configures the websocket clients, e.g.
+// with websocket.WithDialer or websocket.WithHeaders
+// They apply after the dialer derived from a custom HTTP transport, see WithHTTPClient.
+func WithWebsocketOptions(opts ...websocket.ClientOption) ClientOption {
+	return func(c *ClobClient) {
+		c.wsOptions = append(c.wsOptions, opts...)
+	}
+}
+
+// withTransport returns a ClientOption that shares an existing transport between clients
+func withTransport(httpClient *httpclient.Client) ClientOption {
+	return func(c *ClobClient) {
+		c.httpClient = httpClient
 	}
 }
 
@@ -105,15 +216,32 @@ func NewClobClientWithOptions(host string, chainID int, privateKey string, creds
 	for _, opt := range opts {
 		opt(client)
 	}
-	
+	client.warnFunderMismatch(client.auth.Load().builder)
+
+	// Load or derive credentials when a credential store is configured
+	if auth := client.auth.Load(); client.credStore != nil && auth.creds == nil && auth.signer != nil {
+		if _, err := client.LoadOrDeriveApiCreds(); err != nil {
+			return nil, err
+		}
+	}
+
 	return client, nil
 }
 
 // GetAddress returns the public address of the signer
 // Based on: py-clob-client-main/py_clob_client/client.py:128-132
 func (c *ClobClient) GetAddress() string {
-	if c.signer != nil {
-		return c.signer.Address()
+	if s := c.auth.Load().signer; s != nil {
+		return s.Address()
+	}
+	return ""
+}
+
+// GetFunderAddress returns the address funding orders (the maker), which is the
+// derived proxy or Safe wallet for POLY_PROXY and POLY_GNOSIS_SAFE signature types
+func (c *ClobClient) GetFunderAddress() string {
+	if builder := c.auth.Load().builder; builder != nil {
+		return builder.GetFunder()
 	}
 	return ""
 }
@@ -163,12 +291,13 @@ func (c *ClobClient) GetServerTime() (map[string]interface{}, error) {
 // CreateApiKey creates a new CLOB API key
 // Based on: py-clob-client-main/py_clob_client/client.py:172-191
 func (c *ClobClient) CreateApiKey(nonce *int) (*types.ApiCreds, error) {
-	if err := c.assertLevel1Auth(); err != nil {
+	auth, err := c.assertLevel1Auth()
+	if err != nil {
 		return nil, err
 	}
 
 	endpoint := c.host + types.CREATE_API_KEY
-	headers, err := headers.CreateLevel1Headers(c.signer, nonce)
+	headers, err := headers.CreateLevel1Headers(auth.signer, nonce)
 	if err != nil {
 		return nil, err
 	}
@@ -185,10 +314,10 @@ func (c *ClobClient) CreateApiKey(nonce *int) (*types.ApiCreds, error) {
 		creds.ApiKey = apiKey
 	}
 	if secret, ok := response["secret"].(string); ok {
-		creds.ApiSecret = secret
+		creds.ApiSecret = types.Secret(secret)
 	}
 	if passphrase, ok := response["passphrase"].(string); ok {
-		creds.ApiPassphrase = passphrase
+		creds.ApiPassphrase = types.Secret(passphrase)
 	}
 
 	return creds, nil
@@ -197,12 +326,13 @@ func (c *ClobClient) CreateApiKey(nonce *int) (*types.ApiCreds, error) {
 // DeriveApiKey derives an existing CLOB API key
 // Based on: py-clob-client-main/py_clob_client/client.py:193-212
 func (c *ClobClient) DeriveApiKey(nonce *int) (*types.ApiCreds, error) {
-	if err := c.assertLevel1Auth(); err != nil {
+	auth, err := c.assertLevel1Auth()
+	if err != nil {
 		return nil, err
 	}
 
 	endpoint := c.host + types.DERIVE_API_KEY
-	headers, err := headers.CreateLevel1Headers(c.signer, nonce)
+	headers, err := headers.CreateLevel1Headers(auth.signer, nonce)
 	if err != nil {
 		return nil, err
 	}
@@ -219,10 +349,10 @@ func (c *ClobClient) DeriveApiKey(nonce *int) (*types.ApiCreds, error) {
 		creds.ApiKey = apiKey
 	}
 	if secret, ok := response["secret"].(string); ok {
-		creds.ApiSecret = secret
+		creds.ApiSecret = types.Secret(secret)
 	}
 	if passphrase, ok := response["passphrase"].(string); ok {
-		creds.ApiPassphrase = passphrase
+		creds.ApiPassphrase = types.Secret(passphrase)
 	}
 
 	return creds, nil
@@ -242,10 +372,68 @@ func (c *ClobClient) CreateOrDeriveApiCreds(nonce *int) (*types.ApiCreds, error)
 }
 
 // SetApiCreds sets the client API credentials
+// Requests already signed keep the credentials they were signed with
 // Based on: py-clob-client-main/py_clob_client/client.py:223-228
 func (c *ClobClient) SetApiCreds(creds *types.ApiCreds) {
-	c.creds = creds
-	c.mode = c.getClientMode()
+	c.authMu.Lock()
+	defer c.authMu.Unlock()
+
+	old := c.auth.Load()
+	c.auth.Store(newAuthState(old.signer, creds, old.builder))
+}
+
+// SetSigner replaces the signer together with its API credentials (nil creds give an L1 client)
+// An empty private key removes the signer, leaving an L0 client
+func (c *ClobClient) SetSigner(privateKey string, creds *types.ApiCreds, signatureType *model.SignatureType, funder *string) error {
+	var s *signer.Signer
+	var builder *orderbuilder.OrderBuilder
+	if privateKey != "" {
+		var err error
+		s, err = signer.NewSigner(privateKey, c.chainID)
+		if err != nil {
+			return fmt.Errorf("failed to create signer: %w", err)
+		}
+		builder = newOrderBuilder(s, signatureType, funder)
+	}
+
+	c.authMu.Lock()
+	defer c.authMu.Unlock()
+
+	c.auth.Store(newAuthState(s, creds, builder))
+	c.warnFunderMismatch(builder)
+	return nil
+}
+
+// SetRemoteSigner switches the client to a signer whose private key is held elsewhere, e.g.
+// a signing daemon (see remotesigner.NewSigner), together with the API credentials of its address
+func (c *ClobClient) SetRemoteSigner(s *signer.Signer, creds *types.ApiCreds, signatureType *model.SignatureType, funder *string) error {
+	if s.GetChainID() != c.chainID {
+		return fmt.Errorf("signer is on chain %d, client is on chain %d", s.GetChainID(), c.chainID)
+	}
+
+	builder := newOrderBuilder(s, signatureType, funder)
+
+	c.authMu.Lock()
+	defer c.authMu.Unlock()
+
+	c.auth.Store(newAuthState(s, creds, builder))
+	c.warnFunderMismatch(builder)
+	return nil
+}
+
+// warnFunderMismatch logs a warning when the funder of an order builder is not the wallet
+// derived from its signer
+func (c *ClobClient) warnFunderMismatch(builder *orderbuilder.OrderBuilder) {
+	if builder == nil {
+		return
+	}
+	if derived, mismatch := builder.FunderMismatch(); mismatch {
+		c.logger.Warn("Funder does not match the wallet derived from the signer",
+			"funder", builder.GetFunder(),
+			"derived", derived,
+			"signer", c.GetAddress(),
+			"signature_type", builder.GetSignatureType())
+	}
 }
 
 // SetHTTPClient sets a custom HTTP client for the ClobClient
@@ -255,8 +443,10 @@ func (c *ClobClient) SetHTTPClient(httpClient *http.Client) {
 
 // GetApiKeys gets the available API keys for this address
 // Based on: py-clob-client-main/py_clob_client/client.py:230-239
+// See ListApiKeys for a typed variant
 func (c *ClobClient) GetApiKeys() (map[string]interface{}, error) {
-	if err := c.assertLevel2Auth(); err != nil {
+	auth, err := c.assertLevel2Auth()
+	if err != nil {
 		return nil, err
 	}
 
@@ -265,7 +455,7 @@ func (c *ClobClient) GetApiKeys() (map[string]interface{}, error) {
 		RequestPath: types.GET_API_KEYS,
 	}
 
-	h, err := headers.CreateLevel2Headers(c.signer, c.creds, requestArgs)
+	h, err := headers.CreateLevel2Headers(auth.signer, auth.creds, requestArgs)
 	if err != nil {
 		return nil, err
 	}
@@ -302,7 +492,7 @@ func (c *ClobClient) GetPrice(tokenID string, side string) (map[string]interface
 func (c *ClobClient) GetTickSize(tokenID string) (types.TickSize, error) {
 	// Check cache first
 	// Based on: py-clob-client-main/py_clob_client/client.py:303-304
-	if tickSize, ok := c.tickSizes[tokenID]; ok {
+	if tickSize, ok := c.cache.TickSize(tokenID); ok {
 		return tickSize, nil
 	}
 
@@ -335,7 +525,7 @@ func (c *ClobClient) GetTickSize(tokenID string) (types.TickSize, error) {
 	}
 	
 	tickSize := types.TickSize(tickSizeStr)
-	c.tickSizes[tokenID] = tickSize
+	c.cache.SetTickSize(tokenID, tickSize)
 	return tickSize, nil
 }
 
@@ -344,7 +534,7 @@ func (c *ClobClient) GetTickSize(tokenID string) (types.TickSize, error) {
 func (c *ClobClient) GetNegRisk(tokenID string) (bool, error) {
 	// Check cache first
 	// Based on: py-clob-client-main/py_clob_client/client.py:312-313
-	if negRisk, ok := c.negRisk[tokenID]; ok {
+	if negRisk, ok := c.cache.NegRisk(tokenID); ok {
 		return negRisk, nil
 	}
 
@@ -357,7 +547,7 @@ func (c *ClobClient) GetNegRisk(tokenID string) (bool, error) {
 	// Parse and cache result
 	// Based on: py-clob-client-main/py_clob_client/client.py:316
 	if negRisk, ok := result["neg_risk"].(bool); ok {
-		c.negRisk[tokenID] = negRisk
+		c.cache.SetNegRisk(tokenID, negRisk)
 		return negRisk, nil
 	}
 
@@ -387,7 +577,8 @@ func (c *ClobClient) resolveTickSize(tokenID string, tickSize *types.TickSize) (
 // CreateOrder creates and signs an order
 // Based on: py-clob-client-main/py_clob_client/client.py:336-373
 func (c *ClobClient) CreateOrder(orderArgs *types.OrderArgs, options *types.PartialCreateOrderOptions) (*model.SignedOrder, error) {
-	if err := c.assertLevel1Auth(); err != nil {
+	auth, err := c.assertLevel1Auth()
+	if err != nil {
 		return nil, err
 	}
 
@@ -425,34 +616,38 @@ func (c *ClobClient) CreateOrder(orderArgs *types.OrderArgs, options *types.Part
 		NegRisk:  negRisk,
 	}
 
-	return c.builder.CreateOrder(orderArgs, createOptions)
+	return auth.builder.CreateOrder(orderArgs, createOptions)
 }
 
 // assertLevel1Auth checks for Level 1 authentication
+// It returns the authentication snapshot the caller should use for the whole request
 // Based on: py-clob-client-main/py_clob_client/client.py:584-589
-func (c *ClobClient) assertLevel1Auth() error {
-	if c.mode < types.L1 {
-		return errors.ErrL1AuthUnavailable
+func (c *ClobClient) assertLevel1Auth() (*authState, error) {
+	auth := c.auth.Load()
+	if auth.mode < types.L1 {
+		return nil, errors.ErrL1AuthUnavailable
 	}
-	return nil
+	return auth, nil
 }
 
 // assertLevel2Auth checks for Level 2 authentication
+// It returns the authentication snapshot the caller should use for the whole request
 // Based on: py-clob-client-main/py_clob_client/client.py:591-596
-func (c *ClobClient) assertLevel2Auth() error {
-	if c.mode < types.L2 {
-		return errors.ErrL2AuthUnavailable
+func (c *ClobClient) assertLevel2Auth() (*authState, error) {
+	auth := c.auth.Load()
+	if auth.mode < types.L2 {
+		return nil, errors.ErrL2AuthUnavailable
 	}
-	return nil
+	return auth, nil
 }
 
 // getClientMode determines the client authentication mode
 // Based on: py-clob-client-main/py_clob_client/client.py:598-603
-func (c *ClobClient) getClientMode() int {
-	if c.signer != nil && c.creds != nil {
+func (a *authState) getClientMode() int {
+	if a.signer != nil && a.creds != nil {
 		return types.L2
 	}
-	if c.signer != nil {
+	if a.signer != nil {
 		return types.L1
 	}
 	return types.L0
@@ -470,12 +665,12 @@ func (c *ClobClient) CalculateMarketPrice(tokenID string, side string, amount fl
 		if len(book.Asks) == 0 {
 			return 0, errors.ErrNoMatch
 		}
-		return c.builder.CalculateBuyMarketPrice(book.Asks, amount)
+		return c.auth.Load().builder.CalculateBuyMarketPrice(book.Asks, amount)
 	} else {
 		if len(book.Bids) == 0 {
 			return 0, errors.ErrNoMatch
 		}
-		return c.builder.CalculateSellMarketPrice(book.Bids, amount)
+		return c.auth.Load().builder.CalculateSellMarketPrice(book.Bids, amount)
 	}
 }
 
@@ -491,14 +686,24 @@ func (c *ClobClient) GetOrderBook(tokenID string) (*types.OrderBookSummary, erro
 	return utilities.ParseRawOrderbookSummary(rawObs)
 }
 
+// websocketHost is the official Polymarket CLOB websocket endpoint
+// Based on: https://docs.polymarket.com/developers/CLOB/websocket/wss-overview
+const websocketHost = "wss://ws-subscriptions-clob.polymarket.com"
+
+// websocketOptions returns the options of the websocket clients: the logger, a dialer
+// matching a custom HTTP transport, then the options of WithWebsocketOptions
+func (c *ClobClient) websocketOptions() []websocket.ClientOption {
+	opts := []websocket.ClientOption{websocket.WithLogger(c.logger)}
+	if transport, ok := c.httpClient.GetHTTPClient().Transport.(*http.Transport); ok {
+		opts = append(opts, websocket.WithDialer(websocket.DialerFromTransport(transport)))
+	}
+	return append(opts, c.wsOptions...)
+}
+
 // CreateWebSocketClient creates a new websocket client for real-time data
 // Based on: clob-client-main/examples/socketConnection.ts
 func (c *ClobClient) CreateWebSocketClient(handler websocket.MessageHandler) *websocket.Client {
-	// Use the official Polymarket CLOB websocket endpoint
-	// Based on: https://docs.polymarket.com/developers/CLOB/websocket/wss-overview
-	wsHost := "wss://ws-subscriptions-clob.polymarket.com"
-	
-	return websocket.NewClient(wsHost, handler)
+	return websocket.NewClientWithOptions(websocketHost, handler, c.websocketOptions()...)
 }
 
 // SubscribeToMarketData creates a websocket connection and subscribes to market data
@@ -514,16 +719,48 @@ func (c *ClobClient) SubscribeToMarketData(tokenIDs []string, handler websocket.
 	return client, nil
 }
 
+// SubscribeToMarketEvents creates a websocket connection delivering market data on an event channel
+func (c *ClobClient) SubscribeToMarketEvents(tokenIDs []string, config websocket.StreamConfig) (*websocket.Client, *websocket.EventStream, error) {
+	client, stream := websocket.NewEventClient(websocketHost, config, c.websocketOptions()...)
+
+	if err := client.SubscribeToMarket(tokenIDs, true); err != nil {
+		_ = client.Close() // Best effort cleanup
+		return nil, nil, fmt.Errorf("failed to subscribe to market: %w", err)
+	}
+
+	return client, stream, nil
+}
+
+// SubscribeToMarketPool subscribes to market data over a pool of connections, sharding the
+// token IDs by PoolConfig.ShardSize, with the events of all connections on one stream
+func (c *ClobClient) SubscribeToMarketPool(tokenIDs []string, config websocket.PoolConfig) (*websocket.Pool, error) {
+	pool := websocket.NewPool(websocketHost, config, c.websocketOptions()...)
+
+	if err := pool.Subscribe(tokenIDs...); err != nil {
+		_ = pool.Close() // Best effort cleanup
+		return nil, fmt.Errorf("failed to subscribe to market: %w", err)
+	}
+
+	return pool, nil
+}
+
+// NewMarketHub creates a hub sharing market data connections between the components of a
+// process, each subscribing to filtered views of the events
+func (c *ClobClient) NewMarketHub(config websocket.HubConfig) *websocket.Hub {
+	return websocket.NewHub(websocketHost, config, c.websocketOptions()...)
+}
+
 // SubscribeToUserData creates a websocket connection and subscribes to user data
 // Based on: clob-client-main/examples/socketConnection.ts:61
 func (c *ClobClient) SubscribeToUserData(markets []string, handler websocket.MessageHandler) (*websocket.Client, error) {
-	if err := c.assertLevel2Auth(); err != nil {
+	auth, err := c.assertLevel2Auth()
+	if err != nil {
 		return nil, err
 	}
 	
 	client := c.CreateWebSocketClient(handler)
 	
-	if err := client.SubscribeToUser(c.creds, markets, true); err != nil {
+	if err := client.SubscribeToUser(auth.creds, markets, true); err != nil {
 		_ = client.Close() // Best effort cleanup
 		return nil, fmt.Errorf("failed to subscribe to user data: %w", err)
 	}
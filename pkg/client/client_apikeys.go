@@ -0,0 +1,325 @@
+package client
+
+import (
+	stderrors "errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/pooofdevelopment/go-clob-client/pkg/errors"
+	"github.com/pooofdevelopment/go-clob-client/pkg/headers"
+	"github.com/pooofdevelopment/go-clob-client/pkg/signer"
+	"github.com/pooofdevelopment/go-clob-client/pkg/types"
+)
+
+// ListApiKeys returns the API keys registered for this address
+// Typed variant of GetApiKeys
+func (c *ClobClient) ListApiKeys() ([]string, error) {
+	auth, err := c.assertLevel2Auth()
+	if err != nil {
+		return nil, err
+	}
+	return c.listApiKeys(auth.signer, auth.creds)
+}
+
+// listApiKeys fetches the API keys using the given signer and credentials
+func (c *ClobClient) listApiKeys(s *signer.Signer, creds *types.ApiCreds) ([]string, error) {
+	requestArgs := &types.RequestArgs{
+		Method:      "GET",
+		RequestPath: types.GET_API_KEYS,
+	}
+
+	h, err := headers.CreateLevel2Headers(s, creds, requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.httpClient.Get(c.host+types.GET_API_KEYS, h)
+	if err != nil {
+		return nil, err
+	}
+
+	// The API returns {"apiKeys": [...]}, with entries either plain keys or objects holding the key
+	var keys []string
+	rawKeys, ok := response["apiKeys"].([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected api keys response: %v", response)
+	}
+	for _, raw := range rawKeys {
+		switch v := raw.(type) {
+		case string:
+			keys = append(keys, v)
+		case map[string]interface{}:
+			if key, ok := v["apiKey"].(string); ok {
+				keys = append(keys, key)
+			} else if key, ok := v["key"].(string); ok {
+				keys = append(keys, key)
+			}
+		}
+	}
+
+	return keys, nil
+}
+
+// VerifyApiCreds checks that the given credentials authenticate against the API
+// The credentials are valid when an authenticated call succeeds and lists their key.
+// Credentials the server rejects fail with an error matching errors.ErrApiKeyRejected;
+// other errors (timeouts, 5xx, rate limits) say nothing about the credentials.
+func (c *ClobClient) VerifyApiCreds(creds *types.ApiCreds) error {
+	auth, err := c.assertLevel1Auth()
+	if err != nil {
+		return err
+	}
+	if creds == nil {
+		return fmt.Errorf("credentials are required")
+	}
+
+	keys, err := c.listApiKeys(auth.signer, creds)
+	if err != nil {
+		switch status, _ := errors.StatusCode(err); status {
+		case http.StatusUnauthorized, http.StatusForbidden:
+			return fmt.Errorf("%w: api key %s: %w", errors.ErrApiKeyRejected, creds.ApiKey, err)
+		}
+		return fmt.Errorf("failed to verify api key %s: %w", creds.ApiKey, err)
+	}
+
+	for _, key := range keys {
+		if key == creds.ApiKey {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: api key %s is not registered for %s", errors.ErrApiKeyRejected, creds.ApiKey, auth.signer.Address())
+}
+
+// RevokeApiKey deletes the API key of the given credentials
+// The delete endpoint always removes the key used to authenticate the request
+func (c *ClobClient) RevokeApiKey(creds *types.ApiCreds) error {
+	auth, err := c.assertLevel1Auth()
+	if err != nil {
+		return err
+	}
+	if creds == nil {
+		return fmt.Errorf("credentials are required")
+	}
+
+	requestArgs := &types.RequestArgs{
+		Method:      "DELETE",
+		RequestPath: types.DELETE_API_KEY,
+	}
+
+	h, err := headers.CreateLevel2Headers(auth.signer, creds, requestArgs)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.httpClient.Delete(c.host+types.DELETE_API_KEY, h, nil)
+	return err
+}
+
+// RotateApiKey replaces the client's API key with a newly created one
+// The new key is created with the given nonce (a time based nonce if nil) and verified
+// with an authenticated call before the client is switched to it.
+// The old key is deleted last; if that fails the new credentials are still returned
+// together with the error. Persist the returned credentials: they cannot be derived
+// again without the nonce. Other credential and signer changes wait for the rotation.
+func (c *ClobClient) RotateApiKey(nonce *int) (*types.ApiCreds, error) {
+	c.authMu.Lock()
+	defer c.authMu.Unlock()
+
+	auth, err := c.assertLevel2Auth()
+	if err != nil {
+		return nil, err
+	}
+	oldCreds := auth.creds
+
+	if nonce == nil {
+		n := int(time.Now().Unix())
+		nonce = &n
+	}
+
+	newCreds, err := c.CreateApiKey(nonce)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create api key: %w", err)
+	}
+	if newCreds.ApiKey == "" || newCreds.ApiKey == oldCreds.ApiKey {
+		return nil, fmt.Errorf("api key creation with nonce %d did not return a new key", *nonce)
+	}
+
+	if err := c.VerifyApiCreds(newCreds); err != nil {
+		// Best effort cleanup, the client keeps using the old key
+		_ = c.RevokeApiKey(newCreds)
+		return nil, err
+	}
+
+	c.auth.Store(newAuthState(auth.signer, newCreds, auth.builder))
+
+	if c.credStore != nil {
+		if err := c.credStore.Save(auth.signer.Address(), c.chainID, newCreds); err != nil {
+			return newCreds, fmt.Errorf("switched to api key %s but failed to persist it: %w", newCreds.ApiKey, err)
+		}
+	}
+
+	if err := c.RevokeApiKey(oldCreds); err != nil {
+		return newCreds, fmt.Errorf("switched to api key %s but failed to delete old key %s: %w", newCreds.ApiKey, oldCreds.ApiKey, err)
+	}
+
+	return newCreds, nil
+}
+
+// LoadOrDeriveApiCreds sets up the client credentials using the configured credential store
+// Stored credentials are used if they still authenticate; when the server rejects them, new
+// credentials are created or derived with the store nonce, persisted, and set on the client.
+// Any other verification failure is returned and the store is left untouched.
+func (c *ClobClient) LoadOrDeriveApiCreds() (*types.ApiCreds, error) {
+	auth, err := c.assertLevel1Auth()
+	if err != nil {
+		return nil, err
+	}
+	if c.credStore == nil {
+		return nil, fmt.Errorf("no credential store configured")
+	}
+
+	address := auth.signer.Address()
+	creds, err := c.credStore.Load(address, c.chainID)
+	switch {
+	case err == nil:
+		err := c.VerifyApiCreds(creds)
+		if err == nil {
+			c.SetApiCreds(creds)
+			return creds, nil
+		}
+		if !stderrors.Is(err, errors.ErrApiKeyRejected) {
+			return nil, fmt.Errorf("failed to verify stored credentials: %w", err)
+		}
+	case !stderrors.Is(err, errors.ErrCredentialsNotFound):
+		return nil, fmt.Errorf("failed to load credentials: %w", err)
+	}
+
+	creds, err = c.CreateOrDeriveApiCreds(c.credNonce)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create or derive api key: %w", err)
+	}
+	if creds.ApiKey == "" {
+		return nil, fmt.Errorf("api key creation returned no key")
+	}
+
+	if err := c.credStore.Save(address, c.chainID, creds); err != nil {
+		return nil, fmt.Errorf("failed to persist credentials: %w", err)
+	}
+
+	c.SetApiCreds(creds)
+	return creds, nil
+}
+
+// CreateReadonlyApiKey creates a read-only API key for this address
+// Based on: clob-client-main/src/client.ts (createReadonlyApiKey)
+func (c *ClobClient) CreateReadonlyApiKey() (string, error) {
+	auth, err := c.assertLevel2Auth()
+	if err != nil {
+		return "", err
+	}
+
+	requestArgs := &types.RequestArgs{
+		Method:      "POST",
+		RequestPath: types.CREATE_READONLY_API_KEY,
+	}
+
+	h, err := headers.CreateLevel2Headers(auth.signer, auth.creds, requestArgs)
+	if err != nil {
+		return "", err
+	}
+
+	response, err := c.httpClient.Post(c.host+types.CREATE_READONLY_API_KEY, h, nil)
+	if err != nil {
+		return "", err
+	}
+
+	apiKey, ok := response["apiKey"].(string)
+	if !ok {
+		return "", fmt.Errorf("unexpected read-only api key response: %v", response)
+	}
+
+	return apiKey, nil
+}
+
+// GetReadonlyApiKeys returns the read-only API keys for this address
+// Based on: clob-client-main/src/client.ts (getReadonlyApiKeys)
+func (c *ClobClient) GetReadonlyApiKeys() ([]string, error) {
+	auth, err := c.assertLevel2Auth()
+	if err != nil {
+		return nil, err
+	}
+
+	requestArgs := &types.RequestArgs{
+		Method:      "GET",
+		RequestPath: types.GET_READONLY_API_KEYS,
+	}
+
+	h, err := headers.CreateLevel2Headers(auth.signer, auth.creds, requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.httpClient.Get(c.host+types.GET_READONLY_API_KEYS, h)
+	if err != nil {
+		return nil, err
+	}
+
+	// Array responses are wrapped in "data" by the http client
+	var keys []string
+	if data, ok := response["data"].([]interface{}); ok {
+		for _, item := range data {
+			if key, ok := item.(string); ok {
+				keys = append(keys, key)
+			}
+		}
+	}
+
+	return keys, nil
+}
+
+// DeleteReadonlyApiKey deletes a read-only API key
+// Based on: clob-client-main/src/client.ts (deleteReadonlyApiKey)
+func (c *ClobClient) DeleteReadonlyApiKey(key string) error {
+	auth, err := c.assertLevel2Auth()
+	if err != nil {
+		return err
+	}
+
+	body := map[string]string{"key": key}
+	requestArgs := &types.RequestArgs{
+		Method:      "DELETE",
+		RequestPath: types.DELETE_READONLY_API_KEY,
+		Body:        body,
+	}
+
+	h, err := headers.CreateLevel2Headers(auth.signer, auth.creds, requestArgs)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.httpClient.Delete(c.host+types.DELETE_READONLY_API_KEY, h, body)
+	return err
+}
+
+// ValidateReadonlyApiKey checks whether a read-only API key belongs to an address
+// A key rejected by the endpoint (401, 403 or 404) is reported as (false, nil); the error is
+// reserved for transport failures and other unexpected responses.
+// Based on: clob-client-main/src/client.ts (validateReadonlyApiKey)
+func (c *ClobClient) ValidateReadonlyApiKey(address string, key string) (bool, error) {
+	q := url.Values{}
+	q.Set("address", address)
+	q.Set("key", key)
+
+	if _, err := c.httpClient.Get(c.host+types.VALIDATE_READONLY_API_KEY+"?"+q.Encode(), nil); err != nil {
+		switch status, _ := errors.StatusCode(err); status {
+		case http.StatusUnauthorized, http.StatusForbidden, http.StatusNotFound:
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
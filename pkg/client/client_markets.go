@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/pooofdevelopment/go-clob-client/pkg/gamma"
 	"github.com/pooofdevelopment/go-clob-client/pkg/headers"
 	"github.com/pooofdevelopment/go-clob-client/pkg/httpclient"
 	"github.com/pooofdevelopment/go-clob-client/pkg/types"
@@ -18,7 +19,8 @@ import (
 // GetClosedOnlyMode gets the closed only mode flag for this address
 // Based on: py-clob-client-main/py_clob_client/client.py:241-250
 func (c *ClobClient) GetClosedOnlyMode() (map[string]interface{}, error) {
-	if err := c.assertLevel2Auth(); err != nil {
+	auth, err := c.assertLevel2Auth()
+	if err != nil {
 		return nil, err
 	}
 
@@ -27,7 +29,7 @@ func (c *ClobClient) GetClosedOnlyMode() (map[string]interface{}, error) {
 		RequestPath: types.CLOSED_ONLY,
 	}
 
-	h, err := headers.CreateLevel2Headers(c.signer, c.creds, requestArgs)
+	h, err := headers.CreateLevel2Headers(auth.signer, auth.creds, requestArgs)
 	if err != nil {
 		return nil, err
 	}
@@ -37,8 +39,10 @@ func (c *ClobClient) GetClosedOnlyMode() (map[string]interface{}, error) {
 
 // DeleteApiKey deletes an API key
 // Based on: py-clob-client-main/py_clob_client/client.py:252-261
+// See RevokeApiKey and RotateApiKey for typed variants
 func (c *ClobClient) DeleteApiKey() (map[string]interface{}, error) {
-	if err := c.assertLevel2Auth(); err != nil {
+	auth, err := c.assertLevel2Auth()
+	if err != nil {
 		return nil, err
 	}
 
@@ -47,7 +51,7 @@ func (c *ClobClient) DeleteApiKey() (map[string]interface{}, error) {
 		RequestPath: types.DELETE_API_KEY,
 	}
 
-	h, err := headers.CreateLevel2Headers(c.signer, c.creds, requestArgs)
+	h, err := headers.CreateLevel2Headers(auth.signer, auth.creds, requestArgs)
 	if err != nil {
 		return nil, err
 	}
@@ -140,7 +144,8 @@ func (c *ClobClient) GetLastTradesPrices(params []types.BookParams) (map[string]
 // GetNotifications fetches the notifications for a user
 // Based on: py-clob-client-main/py_clob_client/client.py:605-617
 func (c *ClobClient) GetNotifications() (map[string]interface{}, error) {
-	if err := c.assertLevel2Auth(); err != nil {
+	auth, err := c.assertLevel2Auth()
+	if err != nil {
 		return nil, err
 	}
 
@@ -149,19 +154,20 @@ func (c *ClobClient) GetNotifications() (map[string]interface{}, error) {
 		RequestPath: types.GET_NOTIFICATIONS,
 	}
 
-	h, err := headers.CreateLevel2Headers(c.signer, c.creds, requestArgs)
+	h, err := headers.CreateLevel2Headers(auth.signer, auth.creds, requestArgs)
 	if err != nil {
 		return nil, err
 	}
 
-	url := fmt.Sprintf("%s%s?signature_type=%d", c.host, types.GET_NOTIFICATIONS, c.builder.GetSignatureType())
+	url := fmt.Sprintf("%s%s?signature_type=%d", c.host, types.GET_NOTIFICATIONS, auth.builder.GetSignatureType())
 	return c.httpClient.Get(url, h)
 }
 
 // DropNotifications drops the notifications for a user
 // Based on: py-clob-client-main/py_clob_client/client.py:619-629
 func (c *ClobClient) DropNotifications(params *types.DropNotificationParams) (map[string]interface{}, error) {
-	if err := c.assertLevel2Auth(); err != nil {
+	auth, err := c.assertLevel2Auth()
+	if err != nil {
 		return nil, err
 	}
 
@@ -170,7 +176,7 @@ func (c *ClobClient) DropNotifications(params *types.DropNotificationParams) (ma
 		RequestPath: types.DROP_NOTIFICATIONS,
 	}
 
-	h, err := headers.CreateLevel2Headers(c.signer, c.creds, requestArgs)
+	h, err := headers.CreateLevel2Headers(auth.signer, auth.creds, requestArgs)
 	if err != nil {
 		return nil, err
 	}
@@ -182,7 +188,8 @@ func (c *ClobClient) DropNotifications(params *types.DropNotificationParams) (ma
 // GetBalanceAllowance fetches the balance & allowance for a user
 // Based on: py-clob-client-main/py_clob_client/client.py:631-644
 func (c *ClobClient) GetBalanceAllowance(params *types.BalanceAllowanceParams) (map[string]interface{}, error) {
-	if err := c.assertLevel2Auth(); err != nil {
+	auth, err := c.assertLevel2Auth()
+	if err != nil {
 		return nil, err
 	}
 
@@ -191,7 +198,7 @@ func (c *ClobClient) GetBalanceAllowance(params *types.BalanceAllowanceParams) (
 		RequestPath: types.GET_BALANCE_ALLOWANCE,
 	}
 
-	h, err := headers.CreateLevel2Headers(c.signer, c.creds, requestArgs)
+	h, err := headers.CreateLevel2Headers(auth.signer, auth.creds, requestArgs)
 	if err != nil {
 		return nil, err
 	}
@@ -199,7 +206,7 @@ func (c *ClobClient) GetBalanceAllowance(params *types.BalanceAllowanceParams) (
 	// Set default signature type if not provided
 	// Based on: py-clob-client-main/py_clob_client/client.py:639-640
 	if params.SignatureType == -1 {
-		params.SignatureType = c.builder.GetSignatureType()
+		params.SignatureType = auth.builder.GetSignatureType()
 	}
 
 	url := httpclient.AddBalanceAllowanceParamsToURL(c.host+types.GET_BALANCE_ALLOWANCE, params)
@@ -209,7 +216,8 @@ func (c *ClobClient) GetBalanceAllowance(params *types.BalanceAllowanceParams) (
 // UpdateBalanceAllowance updates the balance & allowance for a user
 // Based on: py-clob-client-main/py_clob_client/client.py:646-659
 func (c *ClobClient) UpdateBalanceAllowance(params *types.BalanceAllowanceParams) (map[string]interface{}, error) {
-	if err := c.assertLevel2Auth(); err != nil {
+	auth, err := c.assertLevel2Auth()
+	if err != nil {
 		return nil, err
 	}
 
@@ -218,7 +226,7 @@ func (c *ClobClient) UpdateBalanceAllowance(params *types.BalanceAllowanceParams
 		RequestPath: types.UPDATE_BALANCE_ALLOWANCE,
 	}
 
-	h, err := headers.CreateLevel2Headers(c.signer, c.creds, requestArgs)
+	h, err := headers.CreateLevel2Headers(auth.signer, auth.creds, requestArgs)
 	if err != nil {
 		return nil, err
 	}
@@ -226,7 +234,7 @@ func (c *ClobClient) UpdateBalanceAllowance(params *types.BalanceAllowanceParams
 	// Set default signature type if not provided
 	// Based on: py-clob-client-main/py_clob_client/client.py:654-655
 	if params.SignatureType == -1 {
-		params.SignatureType = c.builder.GetSignatureType()
+		params.SignatureType = auth.builder.GetSignatureType()
 	}
 
 	url := httpclient.AddBalanceAllowanceParamsToURL(c.host+types.UPDATE_BALANCE_ALLOWANCE, params)
@@ -236,7 +244,8 @@ func (c *ClobClient) UpdateBalanceAllowance(params *types.BalanceAllowanceParams
 // IsOrderScoring checks if the order is currently scoring
 // Based on: py-clob-client-main/py_clob_client/client.py:661-672
 func (c *ClobClient) IsOrderScoring(params *types.OrderScoringParams) (map[string]interface{}, error) {
-	if err := c.assertLevel2Auth(); err != nil {
+	auth, err := c.assertLevel2Auth()
+	if err != nil {
 		return nil, err
 	}
 
@@ -245,7 +254,7 @@ func (c *ClobClient) IsOrderScoring(params *types.OrderScoringParams) (map[strin
 		RequestPath: types.IS_ORDER_SCORING,
 	}
 
-	h, err := headers.CreateLevel2Headers(c.signer, c.creds, requestArgs)
+	h, err := headers.CreateLevel2Headers(auth.signer, auth.creds, requestArgs)
 	if err != nil {
 		return nil, err
 	}
@@ -257,7 +266,8 @@ func (c *ClobClient) IsOrderScoring(params *types.OrderScoringParams) (map[strin
 // AreOrdersScoring checks if the orders are currently scoring
 // Based on: py-clob-client-main/py_clob_client/client.py:674-687
 func (c *ClobClient) AreOrdersScoring(params *types.OrdersScoringParams) (map[string]interface{}, error) {
-	if err := c.assertLevel2Auth(); err != nil {
+	auth, err := c.assertLevel2Auth()
+	if err != nil {
 		return nil, err
 	}
 
@@ -268,7 +278,7 @@ func (c *ClobClient) AreOrdersScoring(params *types.OrdersScoringParams) (map[st
 		Body:        body,
 	}
 
-	h, err := headers.CreateLevel2Headers(c.signer, c.creds, requestArgs)
+	h, err := headers.CreateLevel2Headers(auth.signer, auth.creds, requestArgs)
 	if err != nil {
 		return nil, err
 	}
@@ -545,7 +555,14 @@ func (c *ClobClient) GetAllMarkets() ([]types.Market, error) {
 	return allMarkets, nil
 }
 
+// Gamma returns a client of the full Gamma API sharing the HTTP transport, rate limiter
+// and logger of the client
+func (c *ClobClient) Gamma(opts ...gamma.Option) *gamma.Client {
+	return gamma.NewClient(append([]gamma.Option{gamma.WithTransport(c.httpClient)}, opts...)...)
+}
+
 // GetGammaMarkets fetches markets from the gamma API with advanced filtering
+// See Gamma for the typed client with all the filters and pagination.
 func (c *ClobClient) GetGammaMarkets(params *types.GammaMarketsParams) ([]types.GammaMarket, error) {
 	// Build URL with query parameters
 	baseURL := "https://gamma-api.polymarket.com/markets"
@@ -715,6 +732,12 @@ func (c *ClobClient) GetGammaMarkets(params *types.GammaMarketsParams) ([]types.
 						market.ClobTokenIDs = tokenIDs
 					}
 				}
+				if outcomesStr, ok := m["outcomes"].(string); ok {
+					var outcomes []string
+					if err := json.Unmarshal([]byte(outcomesStr), &outcomes); err == nil {
+						market.Outcomes = outcomes
+					}
+				}
 
 				// Parse enableOrderBook
 				if enableOrderBook, ok := m["enableOrderBook"].(bool); ok {
@@ -843,6 +866,12 @@ func (c *ClobClient) GetGammaMarkets(params *types.GammaMarketsParams) ([]types.
 							market.ClobTokenIDs = tokenIDs
 						}
 					}
+					if outcomesStr, ok := m["outcomes"].(string); ok {
+						var outcomes []string
+						if err := json.Unmarshal([]byte(outcomesStr), &outcomes); err == nil {
+							market.Outcomes = outcomes
+						}
+					}
 
 					// Parse enableOrderBook
 					if enableOrderBook, ok := m["enableOrderBook"].(bool); ok {
@@ -972,6 +1001,10 @@ func (c *ClobClient) GetGammaEvents(params *types.GammaEventsParams) ([]types.Ga
 					if outcomes, ok := m["outcomes"].(string); ok {
 						// Store raw outcomes string in Description for now
 						market.Description = outcomes
+						var parsed []string
+						if err := json.Unmarshal([]byte(outcomes), &parsed); err == nil {
+							market.Outcomes = parsed
+						}
 					}
 					if negRisk, ok := m["negRisk"].(bool); ok {
 						event.NegRisk = negRisk
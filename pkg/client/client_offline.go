@@ -0,0 +1,163 @@
+package client
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/polymarket/go-order-utils/pkg/model"
+	"github.com/pooofdevelopment/go-clob-client/pkg/errors"
+	"github.com/pooofdevelopment/go-clob-client/pkg/orderbuilder"
+	"github.com/pooofdevelopment/go-clob-client/pkg/signer"
+	"github.com/pooofdevelopment/go-clob-client/pkg/types"
+	"github.com/pooofdevelopment/go-clob-client/pkg/utilities"
+)
+
+// CreateOrderOffline creates and signs an order without network access
+// The tick size and neg risk flag of the market must be given explicitly
+func (c *ClobClient) CreateOrderOffline(orderArgs *types.OrderArgs, tickSize types.TickSize, negRisk bool) (*model.SignedOrder, error) {
+	auth, err := c.assertLevel1Auth()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := orderbuilder.RoundingConfig[tickSize]; !ok {
+		return nil, fmt.Errorf("invalid tick size: %s", tickSize)
+	}
+
+	// Validate price
+	// Based on: py-clob-client-main/py_clob_client/client.py:352-359
+	if !utilities.PriceValid(orderArgs.Price, string(tickSize)) {
+		tick, _ := strconv.ParseFloat(string(tickSize), 64)
+		return nil, errors.NewInvalidPriceError(orderArgs.Price, string(tickSize), fmt.Sprintf("%.4f", 1-tick))
+	}
+
+	return auth.builder.CreateOrder(orderArgs, &types.CreateOrderOptions{
+		TickSize: tickSize,
+		NegRisk:  negRisk,
+	})
+}
+
+// CreatePortableOrder creates and signs an order without network access and returns it in the
+// portable format, to be submitted later with PostSignedOrder or PostSignedOrderFile
+func (c *ClobClient) CreatePortableOrder(orderArgs *types.OrderArgs, tickSize types.TickSize, negRisk bool, orderType types.OrderType) (*types.PortableSignedOrder, error) {
+	order, err := c.CreateOrderOffline(orderArgs, tickSize, negRisk)
+	if err != nil {
+		return nil, err
+	}
+
+	options := &types.CreateOrderOptions{TickSize: tickSize, NegRisk: negRisk}
+	return orderbuilder.ToPortable(order, c.chainID, options, orderType), nil
+}
+
+// SetAddressSigner switches the client to an address without private key, together with the
+// API credentials of that address
+// Such a client can submit orders signed elsewhere but cannot sign orders or auth messages itself
+func (c *ClobClient) SetAddressSigner(address string, creds *types.ApiCreds) error {
+	s, err := signer.NewAddressSigner(address, c.chainID)
+	if err != nil {
+		return err
+	}
+
+	c.authMu.Lock()
+	defer c.authMu.Unlock()
+
+	c.auth.Store(newAuthState(s, creds, orderbuilder.NewOrderBuilder(s, nil, nil)))
+	return nil
+}
+
+// ValidateSignedOrder checks that a previously signed order can be submitted by this client
+// The signature must be valid, the order must be for this chain and signed by the client address
+// (the owner of its API key), not expired, and the market tick size and neg risk flag must still
+// match the ones it was signed for
+func (c *ClobClient) ValidateSignedOrder(p *types.PortableSignedOrder) (*model.SignedOrder, error) {
+	auth, err := c.assertLevel2Auth()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.ChainID != c.chainID {
+		return nil, fmt.Errorf("order is for chain %d, client is on chain %d", p.ChainID, c.chainID)
+	}
+
+	order, err := orderbuilder.FromPortable(p)
+	if err != nil {
+		return nil, err
+	}
+
+	if !strings.EqualFold(order.Signer.Hex(), auth.signer.Address()) {
+		return nil, fmt.Errorf("order signer %s does not match client address %s", order.Signer.Hex(), auth.signer.Address())
+	}
+
+	if expiration := order.Expiration.Int64(); expiration != 0 && expiration <= time.Now().Unix() {
+		return nil, fmt.Errorf("order expired at %d", expiration)
+	}
+
+	tokenID := order.TokenId.String()
+	tickSize, err := c.GetTickSize(tokenID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tick size: %w", err)
+	}
+	if tickSize != p.TickSize {
+		return nil, fmt.Errorf("market tick size changed from %s to %s since the order was signed", p.TickSize, tickSize)
+	}
+
+	negRisk, err := c.GetNegRisk(tokenID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get neg risk: %w", err)
+	}
+	if negRisk != p.NegRisk {
+		return nil, fmt.Errorf("order was signed with neg risk %t but the market has neg risk %t", p.NegRisk, negRisk)
+	}
+
+	return order, nil
+}
+
+// PostSignedOrder validates and submits a previously signed order
+func (c *ClobClient) PostSignedOrder(p *types.PortableSignedOrder) (map[string]interface{}, error) {
+	order, err := c.ValidateSignedOrder(p)
+	if err != nil {
+		return nil, err
+	}
+	return c.postValidatedOrder(order, p.OrderType)
+}
+
+// postValidatedOrder submits an order checked by ValidateSignedOrder, as GTC when the
+// portable order has no order type
+func (c *ClobClient) postValidatedOrder(order *model.SignedOrder, orderType types.OrderType) (map[string]interface{}, error) {
+	if orderType == "" {
+		orderType = types.OrderTypeGTC
+	}
+	return c.PostOrder(order, orderType)
+}
+
+// PostSignedOrderFile validates and submits the orders in a signed order file
+// All orders are validated before any is submitted. Submission stops at the first failure;
+// the responses of the orders submitted so far are returned with the error.
+func (c *ClobClient) PostSignedOrderFile(path string) ([]map[string]interface{}, error) {
+	orders, err := orderbuilder.ReadSignedOrderFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	validated := make([]*model.SignedOrder, len(orders))
+	for i, p := range orders {
+		order, err := c.ValidateSignedOrder(p)
+		if err != nil {
+			return nil, fmt.Errorf("order %d: %w", i, err)
+		}
+		validated[i] = order
+	}
+
+	responses := make([]map[string]interface{}, 0, len(orders))
+	for i, order := range validated {
+		resp, err := c.postValidatedOrder(order, orders[i].OrderType)
+		if err != nil {
+			return responses, fmt.Errorf("order %d: %w", i, err)
+		}
+		responses = append(responses, resp)
+	}
+
+	return responses, nil
+}
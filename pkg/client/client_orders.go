@@ -15,7 +15,8 @@ import (
 // CreateMarketOrder creates and signs a market order
 // Based on: py-clob-client-main/py_clob_client/client.py:375-419
 func (c *ClobClient) CreateMarketOrder(orderArgs *types.MarketOrderArgs, options *types.PartialCreateOrderOptions) (*model.SignedOrder, error) {
-	if err := c.assertLevel1Auth(); err != nil {
+	auth, err := c.assertLevel1Auth()
+	if err != nil {
 		return nil, err
 	}
 	
@@ -63,19 +64,20 @@ func (c *ClobClient) CreateMarketOrder(orderArgs *types.MarketOrderArgs, options
 		NegRisk:  negRisk,
 	}
 	
-	return c.builder.CreateMarketOrder(orderArgs, createOptions)
+	return auth.builder.CreateMarketOrder(orderArgs, createOptions)
 }
 
 // PostOrder posts the order to the exchange
 // Based on: py-clob-client-main/py_clob_client/client.py:421-432
 func (c *ClobClient) PostOrder(order *model.SignedOrder, orderType types.OrderType) (map[string]interface{}, error) {
-	if err := c.assertLevel2Auth(); err != nil {
+	auth, err := c.assertLevel2Auth()
+	if err != nil {
 		return nil, err
 	}
 	
 	// Convert order to JSON format
 	// Based on: py-clob-client-main/py_clob_client/client.py:426
-	body := c.orderToJSON(order, orderType)
+	body := orderToJSON(order, orderType, auth.creds.ApiKey)
 	
 	requestArgs := &types.RequestArgs{
 		Method:      "POST",
@@ -83,7 +85,7 @@ func (c *ClobClient) PostOrder(order *model.SignedOrder, orderType types.OrderTy
 		Body:        body,
 	}
 	
-	h, err := headers.CreateLevel2Headers(c.signer, c.creds, requestArgs)
+	h, err := c.createOrderHeaders(auth, requestArgs)
 	if err != nil {
 		return nil, err
 	}
@@ -94,7 +96,8 @@ func (c *ClobClient) PostOrder(order *model.SignedOrder, orderType types.OrderTy
 // PostOrders posts multiple orders to the exchange in a batch
 // Based on the batch order API documentation
 func (c *ClobClient) PostOrders(orders []types.PostOrdersArgs) (*types.BatchOrderResponse, error) {
-	if err := c.assertLevel2Auth(); err != nil {
+	auth, err := c.assertLevel2Auth()
+	if err != nil {
 		return nil, err
 	}
 	
@@ -138,7 +141,7 @@ func (c *ClobClient) PostOrders(orders []types.PostOrdersArgs) (*types.BatchOrde
 		// Build order object matching TypeScript/Python format
 		body[i] = map[string]interface{}{
 			"order":     orderData,
-			"owner":     c.creds.ApiKey,
+			"owner":     auth.creds.ApiKey,
 			"orderType": string(orderArgs.OrderType),
 		}
 	}
@@ -149,7 +152,7 @@ func (c *ClobClient) PostOrders(orders []types.PostOrdersArgs) (*types.BatchOrde
 		Body:        body,
 	}
 	
-	h, err := headers.CreateLevel2Headers(c.signer, c.creds, requestArgs)
+	h, err := c.createOrderHeaders(auth, requestArgs)
 	if err != nil {
 		return nil, err
 	}
@@ -238,7 +241,8 @@ func (c *ClobClient) CreateAndPostOrders(ordersList []struct {
 // Cancel cancels an order
 // Based on: py-clob-client-main/py_clob_client/client.py:443-453
 func (c *ClobClient) Cancel(orderID string) (map[string]interface{}, error) {
-	if err := c.assertLevel2Auth(); err != nil {
+	auth, err := c.assertLevel2Auth()
+	if err != nil {
 		return nil, err
 	}
 	
@@ -250,7 +254,7 @@ func (c *ClobClient) Cancel(orderID string) (map[string]interface{}, error) {
 		Body:        body,
 	}
 	
-	h, err := headers.CreateLevel2Headers(c.signer, c.creds, requestArgs)
+	h, err := c.createOrderHeaders(auth, requestArgs)
 	if err != nil {
 		return nil, err
 	}
@@ -261,7 +265,8 @@ func (c *ClobClient) Cancel(orderID string) (map[string]interface{}, error) {
 // CancelOrders cancels multiple orders
 // Based on: py-clob-client-main/py_clob_client/client.py:455-469
 func (c *ClobClient) CancelOrders(orderIDs []string) (map[string]interface{}, error) {
-	if err := c.assertLevel2Auth(); err != nil {
+	auth, err := c.assertLevel2Auth()
+	if err != nil {
 		return nil, err
 	}
 	
@@ -273,7 +278,7 @@ func (c *ClobClient) CancelOrders(orderIDs []string) (map[string]interface{}, er
 		Body:        body,
 	}
 	
-	h, err := headers.CreateLevel2Headers(c.signer, c.creds, requestArgs)
+	h, err := c.createOrderHeaders(auth, requestArgs)
 	if err != nil {
 		return nil, err
 	}
@@ -284,7 +289,8 @@ func (c *ClobClient) CancelOrders(orderIDs []string) (map[string]interface{}, er
 // CancelAll cancels all available orders for the user
 // Based on: py-clob-client-main/py_clob_client/client.py:471-479
 func (c *ClobClient) CancelAll() (map[string]interface{}, error) {
-	if err := c.assertLevel2Auth(); err != nil {
+	auth, err := c.assertLevel2Auth()
+	if err != nil {
 		return nil, err
 	}
 	
@@ -293,7 +299,7 @@ func (c *ClobClient) CancelAll() (map[string]interface{}, error) {
 		RequestPath: types.CANCEL_ALL,
 	}
 	
-	h, err := headers.CreateLevel2Headers(c.signer, c.creds, requestArgs)
+	h, err := c.createOrderHeaders(auth, requestArgs)
 	if err != nil {
 		return nil, err
 	}
@@ -304,7 +310,8 @@ func (c *ClobClient) CancelAll() (map[string]interface{}, error) {
 // CancelMarketOrders cancels market orders
 // Based on: py-clob-client-main/py_clob_client/client.py:481-495
 func (c *ClobClient) CancelMarketOrders(market string, assetID string) (map[string]interface{}, error) {
-	if err := c.assertLevel2Auth(); err != nil {
+	auth, err := c.assertLevel2Auth()
+	if err != nil {
 		return nil, err
 	}
 	
@@ -319,7 +326,7 @@ func (c *ClobClient) CancelMarketOrders(market string, assetID string) (map[stri
 		Body:        body,
 	}
 	
-	h, err := headers.CreateLevel2Headers(c.signer, c.creds, requestArgs)
+	h, err := c.createOrderHeaders(auth, requestArgs)
 	if err != nil {
 		return nil, err
 	}
@@ -330,7 +337,8 @@ func (c *ClobClient) CancelMarketOrders(market string, assetID string) (map[stri
 // GetOrders gets orders for the API key
 // Based on: py-clob-client-main/py_clob_client/client.py:497-516
 func (c *ClobClient) GetOrders(params *types.OpenOrderParams, nextCursor string) ([]types.Order, error) {
-	if err := c.assertLevel2Auth(); err != nil {
+	auth, err := c.assertLevel2Auth()
+	if err != nil {
 		return nil, err
 	}
 	
@@ -339,7 +347,7 @@ func (c *ClobClient) GetOrders(params *types.OpenOrderParams, nextCursor string)
 		RequestPath: types.ORDERS,
 	}
 	
-	h, err := headers.CreateLevel2Headers(c.signer, c.creds, requestArgs)
+	h, err := headers.CreateLevel2Headers(auth.signer, auth.creds, requestArgs)
 	if err != nil {
 		return nil, err
 	}
@@ -385,7 +393,8 @@ func (c *ClobClient) GetOrders(params *types.OpenOrderParams, nextCursor string)
 // GetOrder fetches the order corresponding to the order_id
 // Based on: py-clob-client-main/py_clob_client/client.py:539-548
 func (c *ClobClient) GetOrder(orderID string) (map[string]interface{}, error) {
-	if err := c.assertLevel2Auth(); err != nil {
+	auth, err := c.assertLevel2Auth()
+	if err != nil {
 		return nil, err
 	}
 	
@@ -395,7 +404,7 @@ func (c *ClobClient) GetOrder(orderID string) (map[string]interface{}, error) {
 		RequestPath: endpoint,
 	}
 	
-	h, err := headers.CreateLevel2Headers(c.signer, c.creds, requestArgs)
+	h, err := headers.CreateLevel2Headers(auth.signer, auth.creds, requestArgs)
 	if err != nil {
 		return nil, err
 	}
@@ -406,7 +415,8 @@ func (c *ClobClient) GetOrder(orderID string) (map[string]interface{}, error) {
 // GetTrades fetches the trade history for a user
 // Based on: py-clob-client-main/py_clob_client/client.py:550-569
 func (c *ClobClient) GetTrades(params *types.TradeParams, nextCursor string) ([]types.Trade, error) {
-	if err := c.assertLevel2Auth(); err != nil {
+	auth, err := c.assertLevel2Auth()
+	if err != nil {
 		return nil, err
 	}
 	
@@ -415,7 +425,7 @@ func (c *ClobClient) GetTrades(params *types.TradeParams, nextCursor string) ([]
 		RequestPath: types.TRADES,
 	}
 	
-	h, err := headers.CreateLevel2Headers(c.signer, c.creds, requestArgs)
+	h, err := headers.CreateLevel2Headers(auth.signer, auth.creds, requestArgs)
 	if err != nil {
 		return nil, err
 	}
@@ -458,9 +468,26 @@ func (c *ClobClient) GetTrades(params *types.TradeParams, nextCursor string) ([]
 	return results, nil
 }
 
+// createOrderHeaders creates the Level 2 headers for an order request, adding the builder
+// attribution headers when a builder signer is configured
+func (c *ClobClient) createOrderHeaders(auth *authState, requestArgs *types.RequestArgs) (map[string]string, error) {
+	h, err := headers.CreateLevel2Headers(auth.signer, auth.creds, requestArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.builderSigner != nil {
+		if err := headers.AddBuilderHeaders(h, c.builderSigner, requestArgs); err != nil {
+			return nil, fmt.Errorf("failed to create builder headers: %w", err)
+		}
+	}
+
+	return h, nil
+}
+
 // orderToJSON converts an order to JSON format for API submission
 // Based on: py-clob-client-main/py_clob_client/utilities.py:35-65
-func (c *ClobClient) orderToJSON(order *model.SignedOrder, orderType types.OrderType) map[string]interface{} {
+func orderToJSON(order *model.SignedOrder, orderType types.OrderType, owner string) map[string]interface{} {
 	// Convert side from int to string
 	sideStr := "BUY"
 	if order.Side.Int64() == 1 {
@@ -487,7 +514,7 @@ func (c *ClobClient) orderToJSON(order *model.SignedOrder, orderType types.Order
 	
 	return map[string]interface{}{
 		"order":     orderData,
-		"owner":     c.creds.ApiKey,
+		"owner":     owner,
 		"orderType": string(orderType),
 	}
 }
\ No newline at end of file
@@ -1,7 +1,6 @@
-package tests
+package client
 
 import (
-	client2 "github.com/pooofdevelopment/go-clob-client/pkg/client"
 	"testing"
 
 	"github.com/pooofdevelopment/go-clob-client/pkg/types"
@@ -63,7 +62,7 @@ func TestNewClobClient(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			client, err := client2.NewClobClient(tt.host, tt.chainID, tt.privateKey, tt.creds, nil, nil)
+			client, err := NewClobClient(tt.host, tt.chainID, tt.privateKey, tt.creds, nil, nil)
 
 			if (err != nil) != tt.wantErr {
 				t.Errorf("NewClobClient() error = %v, wantErr %v", err, tt.wantErr)
@@ -71,8 +70,8 @@ func TestNewClobClient(t *testing.T) {
 			}
 
 			if err == nil {
-				if client.mode != tt.wantMode {
-					t.Errorf("NewClobClient() mode = %v, want %v", client.mode, tt.wantMode)
+				if mode := client.auth.Load().mode; mode != tt.wantMode {
+					t.Errorf("NewClobClient() mode = %v, want %v", mode, tt.wantMode)
 				}
 
 				// Check host normalization
@@ -91,8 +90,8 @@ func TestNewClobClient(t *testing.T) {
 // TestGetClientMode tests the client mode determination
 func TestGetClientMode(t *testing.T) {
 	// Test L0 mode
-	client := &client2.ClobClient{}
-	if mode := client.getClientMode(); mode != types.L0 {
+	auth := &authState{}
+	if mode := auth.getClientMode(); mode != types.L0 {
 		t.Errorf("getClientMode() = %v, want %v", mode, types.L0)
 	}
 
@@ -103,35 +102,36 @@ func TestGetClientMode(t *testing.T) {
 // TestAssertAuth tests authentication assertions
 func TestAssertAuth(t *testing.T) {
 	// L0 client
-	client := &client2.ClobClient{mode: types.L0}
+	client := &ClobClient{}
+	client.auth.Store(&authState{mode: types.L0})
 
-	if err := client.assertLevel1Auth(); err == nil {
+	if _, err := client.assertLevel1Auth(); err == nil {
 		t.Error("assertLevel1Auth() should fail for L0 client")
 	}
 
-	if err := client.assertLevel2Auth(); err == nil {
+	if _, err := client.assertLevel2Auth(); err == nil {
 		t.Error("assertLevel2Auth() should fail for L0 client")
 	}
 
 	// L1 client
-	client.mode = types.L1
+	client.auth.Store(&authState{mode: types.L1})
 
-	if err := client.assertLevel1Auth(); err != nil {
+	if _, err := client.assertLevel1Auth(); err != nil {
 		t.Error("assertLevel1Auth() should succeed for L1 client")
 	}
 
-	if err := client.assertLevel2Auth(); err == nil {
+	if _, err := client.assertLevel2Auth(); err == nil {
 		t.Error("assertLevel2Auth() should fail for L1 client")
 	}
 
 	// L2 client
-	client.mode = types.L2
+	client.auth.Store(&authState{mode: types.L2})
 
-	if err := client.assertLevel1Auth(); err != nil {
+	if _, err := client.assertLevel1Auth(); err != nil {
 		t.Error("assertLevel1Auth() should succeed for L2 client")
 	}
 
-	if err := client.assertLevel2Auth(); err != nil {
+	if _, err := client.assertLevel2Auth(); err != nil {
 		t.Error("assertLevel2Auth() should succeed for L2 client")
 	}
 }
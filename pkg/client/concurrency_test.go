@@ -0,0 +1,125 @@
+package client_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/pooofdevelopment/go-clob-client/pkg/client"
+	"github.com/pooofdevelopment/go-clob-client/pkg/types"
+)
+
+// TestConcurrentCredentialSwap tests swapping credentials and transport while orders are posted
+// Run with -race to check for data races
+func TestConcurrentCredentialSwap(t *testing.T) {
+	var mismatches, posted int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case types.GET_TICK_SIZE:
+			_, _ = w.Write([]byte(`{"minimum_tick_size":0.01}`))
+			return
+		case types.POST_ORDER:
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var body struct {
+			Owner string `json:"owner"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+
+		// The owner, API key and passphrase must all come from the same credentials
+		apiKey := r.Header.Get("POLY_API_KEY")
+		if body.Owner != apiKey || r.Header.Get("POLY_PASSPHRASE") != "passphrase-"+apiKey {
+			atomic.AddInt32(&mismatches, 1)
+		}
+		atomic.AddInt32(&posted, 1)
+		_, _ = w.Write([]byte(`{"success":true}`))
+	}))
+	defer server.Close()
+
+	credsFor := func(key string) *types.ApiCreds {
+		return &types.ApiCreds{
+			ApiKey:        key,
+			ApiSecret:     "c2VjcmV0LXNlY3JldC1zZWNyZXQ=",
+			ApiPassphrase: types.Secret("passphrase-" + key),
+		}
+	}
+
+	privateKey := "0xac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80"
+	c, err := client.NewClobClient(server.URL, 137, privateKey, credsFor("key-a"), nil, nil)
+	if err != nil {
+		t.Fatalf("NewClobClient() failed: %v", err)
+	}
+
+	negRisk := false
+	order, err := c.CreateOrder(&types.OrderArgs{
+		TokenID: "1234",
+		Price:   0.5,
+		Size:    10,
+		Side:    types.BUY,
+	}, &types.PartialCreateOrderOptions{NegRisk: &negRisk})
+	if err != nil {
+		t.Fatalf("CreateOrder() failed: %v", err)
+	}
+
+	const workers, ordersPerWorker = 8, 25
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < ordersPerWorker; j++ {
+				if _, err := c.PostOrder(order, types.OrderTypeGTC); err != nil {
+					t.Errorf("PostOrder() failed: %v", err)
+					return
+				}
+			}
+		}()
+	}
+
+	var swappers sync.WaitGroup
+	swappers.Add(1)
+	go func() {
+		defer swappers.Done()
+		keys := []string{"key-a", "key-b", "key-c"}
+		for i := 0; ; i++ {
+			select {
+			case <-done:
+				return
+			default:
+			}
+			c.SetApiCreds(credsFor(keys[i%len(keys)]))
+			c.SetHTTPClient(&http.Client{})
+			_ = c.GetAddress()
+		}
+	}()
+
+	wg.Wait()
+	close(done)
+	swappers.Wait()
+
+	if posted != workers*ordersPerWorker {
+		t.Errorf("server received %d orders, want %d", posted, workers*ordersPerWorker)
+	}
+	if mismatches != 0 {
+		t.Errorf("%d requests mixed credentials", mismatches)
+	}
+
+	// Swapping the signer downgrades to L1 until credentials are given
+	if err := c.SetSigner("0x59c6995e998f97a5a0044966f0945389dc9e86dae88c7a8412f4603b6b78690d", nil, nil, nil); err != nil {
+		t.Fatalf("SetSigner() failed: %v", err)
+	}
+	if c.GetAddress() != "0x70997970c51812dc3a010c7d01b50e0d17dc79c8" {
+		t.Errorf("GetAddress() after SetSigner() = %s", c.GetAddress())
+	}
+	if _, err := c.PostOrder(order, types.OrderTypeGTC); err == nil {
+		t.Error("PostOrder() should require L2 auth after SetSigner() without credentials")
+	}
+}
@@ -0,0 +1,74 @@
+package client_test
+
+import (
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/pooofdevelopment/go-clob-client/pkg/client"
+	"github.com/pooofdevelopment/go-clob-client/pkg/credstore"
+)
+
+// TestWithCredentialStore tests that credentials are derived once and reused afterwards
+func TestWithCredentialStore(t *testing.T) {
+	fake := &fakeKeyServer{keys: map[string]bool{}}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	store, err := credstore.NewFileStore(filepath.Join(t.TempDir(), "creds.json"), []byte("correct horse"))
+	if err != nil {
+		t.Fatalf("NewFileStore() failed: %v", err)
+	}
+
+	privateKey := "0xac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80"
+	nonce := 3
+	first, err := client.NewClobClientWithOptions(server.URL, 137, privateKey, nil, nil, nil, client.WithCredentialStore(store, &nonce))
+	if err != nil {
+		t.Fatalf("NewClobClientWithOptions() failed: %v", err)
+	}
+	if _, err := first.ListApiKeys(); err != nil {
+		t.Fatalf("client is not L2 authenticated: %v", err)
+	}
+
+	stored, err := store.Load(first.GetAddress(), 137)
+	if err != nil {
+		t.Fatalf("credentials were not persisted: %v", err)
+	}
+	if stored.ApiKey != "key-3" {
+		t.Errorf("stored key = %s, want key-3", stored.ApiKey)
+	}
+
+	// A second client reuses the stored key instead of creating a new one
+	nonce = 4
+	if _, err := client.NewClobClientWithOptions(server.URL, 137, privateKey, nil, nil, nil, client.WithCredentialStore(store, &nonce)); err != nil {
+		t.Fatalf("NewClobClientWithOptions() failed: %v", err)
+	}
+	if len(fake.keys) != 1 {
+		t.Errorf("server has %d keys, want 1", len(fake.keys))
+	}
+
+	// Stored credentials that no longer authenticate are replaced
+	fake.mu.Lock()
+	delete(fake.keys, "key-3")
+	fake.mu.Unlock()
+	if _, err := client.NewClobClientWithOptions(server.URL, 137, privateKey, nil, nil, nil, client.WithCredentialStore(store, &nonce)); err != nil {
+		t.Fatalf("NewClobClientWithOptions() failed: %v", err)
+	}
+	stored, _ = store.Load(first.GetAddress(), 137)
+	if stored.ApiKey != "key-4" {
+		t.Errorf("stored key after invalidation = %s, want key-4", stored.ApiKey)
+	}
+
+	// Failures other than a rejection keep the stored credentials
+	fake.mu.Lock()
+	fake.outage = true
+	fake.mu.Unlock()
+	nonce = 5
+	if _, err := client.NewClobClientWithOptions(server.URL, 137, privateKey, nil, nil, nil, client.WithCredentialStore(store, &nonce)); err == nil {
+		t.Error("NewClobClientWithOptions() during an outage should fail")
+	}
+	stored, _ = store.Load(first.GetAddress(), 137)
+	if stored.ApiKey != "key-4" || len(fake.keys) != 1 {
+		t.Errorf("stored key after an outage = %s with %d server keys, want key-4 unchanged", stored.ApiKey, len(fake.keys))
+	}
+}
@@ -0,0 +1,102 @@
+package client_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/polymarket/go-order-utils/pkg/model"
+	"github.com/pooofdevelopment/go-clob-client/pkg/client"
+)
+
+// logBuffer collects JSON log records written from several goroutines
+type logBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *logBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+// records returns the decoded records with the given message
+func (b *logBuffer) records(msg string) []map[string]interface{} {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var records []map[string]interface{}
+	for _, line := range strings.Split(strings.TrimSpace(b.buf.String()), "\n") {
+		var record map[string]interface{}
+		if json.Unmarshal([]byte(line), &record) == nil && record["msg"] == msg {
+			records = append(records, record)
+		}
+	}
+	return records
+}
+
+func newTestLogger() (*slog.Logger, *logBuffer) {
+	buf := &logBuffer{}
+	return slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug})), buf
+}
+
+// TestClientLogger tests that requests and funder mismatches are logged with structured fields
+func TestClientLogger(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`"OK"`))
+	}))
+	defer server.Close()
+
+	logger, buf := newTestLogger()
+	sigType := model.POLY_PROXY
+	funder := "0x0000000000000000000000000000000000000001"
+	c, err := client.NewClobClientWithOptions(server.URL, 137, "0xac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80", nil, &sigType, &funder,
+		client.WithLogger(logger))
+	if err != nil {
+		t.Fatalf("NewClobClientWithOptions() failed: %v", err)
+	}
+
+	if warnings := buf.records("Funder does not match the wallet derived from the signer"); len(warnings) != 1 || warnings[0]["level"] != "WARN" {
+		t.Errorf("funder warnings = %v, want 1", warnings)
+	}
+
+	if _, err := c.GetOk(); err != nil {
+		t.Fatalf("GetOk() failed: %v", err)
+	}
+	requests := buf.records("HTTP request")
+	if len(requests) != 1 {
+		t.Fatalf("logged %d requests, want 1", len(requests))
+	}
+	if requests[0]["endpoint"] != "/" || requests[0]["status"] != float64(200) || requests[0]["latency"] == nil {
+		t.Errorf("request record = %v", requests[0])
+	}
+}
+
+// TestClientLoggerOrder tests that WithHTTPClient keeps a logger set before it
+func TestClientLoggerOrder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`"OK"`))
+	}))
+	defer server.Close()
+
+	logger, buf := newTestLogger()
+	c, err := client.NewClobClientWithOptions(server.URL, 137, "", nil, nil, nil,
+		client.WithLogger(logger),
+		client.WithHTTPClient(server.Client()))
+	if err != nil {
+		t.Fatalf("NewClobClientWithOptions() failed: %v", err)
+	}
+
+	if _, err := c.GetOk(); err != nil {
+		t.Fatalf("GetOk() failed: %v", err)
+	}
+	if requests := buf.records("HTTP request"); len(requests) != 1 {
+		t.Errorf("logged %d requests, want 1", len(requests))
+	}
+}
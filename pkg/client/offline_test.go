@@ -0,0 +1,122 @@
+package client_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/pooofdevelopment/go-clob-client/pkg/client"
+	"github.com/pooofdevelopment/go-clob-client/pkg/orderbuilder"
+	"github.com/pooofdevelopment/go-clob-client/pkg/types"
+)
+
+// TestOfflineSigning tests signing orders on a cold client and submitting them from a key-less hot client
+func TestOfflineSigning(t *testing.T) {
+	privateKey := "0xac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80"
+	address := "0xf39fd6e51aad88f6f4ce6ab8827279cfffb92266"
+
+	// The cold client never reaches its host
+	cold, err := client.NewClobClient("http://127.0.0.1:0", 137, privateKey, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("NewClobClient() failed: %v", err)
+	}
+
+	var orders []*types.PortableSignedOrder
+	for _, side := range []string{types.BUY, types.SELL} {
+		p, err := cold.CreatePortableOrder(&types.OrderArgs{
+			TokenID: "1234",
+			Price:   0.42,
+			Size:    10,
+			Side:    side,
+		}, "0.01", true, types.OrderTypeGTC)
+		if err != nil {
+			t.Fatalf("CreatePortableOrder() failed: %v", err)
+		}
+		orders = append(orders, p)
+	}
+
+	if _, err := cold.CreateOrderOffline(&types.OrderArgs{TokenID: "1234", Price: 0.995, Size: 10, Side: types.BUY}, "0.01", true); err == nil || !strings.Contains(err.Error(), "max: 0.9900") {
+		t.Errorf("CreateOrderOffline() of a price outside the tick size range = %v, want max 0.9900", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "orders.json")
+	if err := orderbuilder.WriteSignedOrderFile(path, orders); err != nil {
+		t.Fatalf("WriteSignedOrderFile() failed: %v", err)
+	}
+
+	var posted int32
+	negRisk := true
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case types.GET_TICK_SIZE:
+			_, _ = w.Write([]byte(`{"minimum_tick_size":0.01}`))
+		case types.GET_NEG_RISK:
+			_ = json.NewEncoder(w).Encode(map[string]bool{"neg_risk": negRisk})
+		case types.POST_ORDER:
+			var body struct {
+				Order struct {
+					Signer string `json:"signer"`
+				} `json:"order"`
+				OrderType string `json:"orderType"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			if !strings.EqualFold(body.Order.Signer, address) || body.OrderType != string(types.OrderTypeGTC) {
+				t.Errorf("unexpected order: %+v", body)
+			}
+			atomic.AddInt32(&posted, 1)
+			_, _ = w.Write([]byte(`{"success":true}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	hot, err := client.NewClobClient(server.URL, 137, "", nil, nil, nil)
+	if err != nil {
+		t.Fatalf("NewClobClient() failed: %v", err)
+	}
+	creds := &types.ApiCreds{ApiKey: "key", ApiSecret: "c2VjcmV0LXNlY3JldC1zZWNyZXQ=", ApiPassphrase: "passphrase"}
+	if err := hot.SetAddressSigner(address, creds); err != nil {
+		t.Fatalf("SetAddressSigner() failed: %v", err)
+	}
+
+	// The hot client cannot sign
+	if _, err := hot.CreateOrderOffline(&types.OrderArgs{TokenID: "1234", Price: 0.5, Size: 10, Side: types.BUY}, "0.01", false); err == nil {
+		t.Error("CreateOrderOffline() should fail without a private key")
+	}
+
+	responses, err := hot.PostSignedOrderFile(path)
+	if err != nil {
+		t.Fatalf("PostSignedOrderFile() failed: %v", err)
+	}
+	if len(responses) != 2 || atomic.LoadInt32(&posted) != 2 {
+		t.Errorf("posted %d orders with %d responses, want 2", posted, len(responses))
+	}
+
+	// A tampered order is rejected
+	tampered := *orders[0]
+	tampered.Order.MakerAmount = "1"
+	if _, err := hot.PostSignedOrder(&tampered); err == nil || !strings.Contains(err.Error(), "signature") {
+		t.Errorf("PostSignedOrder() error = %v, want a signature error", err)
+	}
+
+	// Orders signed for the wrong exchange are rejected before submission
+	negRisk = false
+	stale, err := client.NewClobClient(server.URL, 137, "", nil, nil, nil)
+	if err != nil {
+		t.Fatalf("NewClobClient() failed: %v", err)
+	}
+	if err := stale.SetAddressSigner(address, creds); err != nil {
+		t.Fatalf("SetAddressSigner() failed: %v", err)
+	}
+	if _, err := stale.PostSignedOrderFile(path); err == nil {
+		t.Error("PostSignedOrderFile() should fail when the market neg risk flag changed")
+	}
+	if atomic.LoadInt32(&posted) != 2 {
+		t.Errorf("posted %d orders, want 2", posted)
+	}
+}
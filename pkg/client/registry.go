@@ -0,0 +1,462 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pooofdevelopment/go-clob-client/pkg/types"
+	"github.com/pooofdevelopment/go-clob-client/pkg/websocket"
+)
+
+// registrySnapshotVersion is the format version of registry snapshots
+const registrySnapshotVersion = 1
+
+// OutcomeToken is an outcome of a market and its token
+type OutcomeToken struct {
+	TokenID string `json:"token_id"`
+	Outcome string `json:"outcome"`
+}
+
+// MarketInfo is the metadata of a market held by a MarketRegistry
+type MarketInfo struct {
+	ConditionID     string         `json:"condition_id"`
+	QuestionID      string         `json:"question_id,omitempty"`
+	Slug            string         `json:"slug,omitempty"`
+	Question        string         `json:"question,omitempty"`
+	Tokens          []OutcomeToken `json:"tokens"`
+	TickSize        types.TickSize `json:"tick_size,omitempty"`
+	NegRisk         bool           `json:"neg_risk"`
+	MinOrderSize    float64        `json:"min_order_size,omitempty"`
+	Active          bool           `json:"active"`
+	Closed          bool           `json:"closed"`
+	AcceptingOrders bool           `json:"accepting_orders"`
+}
+
+// TokenInfo resolves a token to its outcome and market
+type TokenInfo struct {
+	TokenID    string
+	Outcome    string
+	Complement string // Other outcome token of a binary market, empty otherwise
+	Market     MarketInfo
+}
+
+// registrySnapshot is the persisted form of a registry
+type registrySnapshot struct {
+	Version  int          `json:"version"`
+	Updated  time.Time    `json:"updated"`
+	LastPage string       `json:"last_page"`
+	Markets  []MarketInfo `json:"markets"`
+}
+
+// MarketRegistry resolves tokens, outcomes and markets from locally held metadata
+// It bulk-loads the CLOB markets once, then answers lookups without requests. Refresh only
+// fetches the markets listed since the last load, tick size changes are applied from
+// websocket events, and a snapshot can be saved for a fast startup. The tick sizes and neg
+// risk flags are also stored in the market cache of the client, so creating orders for
+// known tokens needs no metadata requests. A MarketRegistry is safe for concurrent use.
+type MarketRegistry struct {
+	client *ClobClient
+
+	mu       sync.RWMutex
+	markets  map[string]*MarketInfo // By condition ID; entries are replaced, never modified
+	tokens   map[string]*MarketInfo
+	slugs    map[string]*MarketInfo
+	lastPage string // Cursor of the last page of markets, where Refresh resumes
+	updated  time.Time
+}
+
+// NewMarketRegistry creates an empty registry loading markets with a client
+func NewMarketRegistry(client *ClobClient) *MarketRegistry {
+	return &MarketRegistry{
+		client:  client,
+		markets: make(map[string]*MarketInfo),
+		tokens:  make(map[string]*MarketInfo),
+		slugs:   make(map[string]*MarketInfo),
+	}
+}
+
+// Load loads all markets
+func (r *MarketRegistry) Load() error {
+	_, err := r.loadFrom("MA==")
+	return err
+}
+
+// Refresh loads the markets listed since the last Load or Refresh and returns how many
+// were added; it loads all markets when nothing was loaded yet
+func (r *MarketRegistry) Refresh() (int, error) {
+	r.mu.RLock()
+	cursor := r.lastPage
+	r.mu.RUnlock()
+
+	if cursor == "" {
+		cursor = "MA=="
+	}
+	return r.loadFrom(cursor)
+}
+
+// loadFrom loads the pages of markets from a cursor to the end
+func (r *MarketRegistry) loadFrom(cursor string) (int, error) {
+	added := 0
+	for cursor != "" && cursor != types.EndCursor {
+		response, err := r.client.GetMarkets(cursor)
+		if err != nil {
+			return added, fmt.Errorf("failed to load markets: %w", err)
+		}
+
+		var page []*MarketInfo
+		if data, ok := response["data"].([]interface{}); ok {
+			for _, item := range data {
+				if raw, ok := item.(map[string]interface{}); ok {
+					if market := parseMarketInfo(raw); market != nil {
+						page = append(page, market)
+					}
+				}
+			}
+		}
+
+		next, _ := response["next_cursor"].(string)
+
+		r.mu.Lock()
+		for _, market := range page {
+			if r.put(market) {
+				added++
+			}
+		}
+		if len(page) > 0 {
+			r.lastPage = cursor
+		}
+		r.updated = time.Now()
+		r.mu.Unlock()
+
+		cursor = next
+	}
+	return added, nil
+}
+
+// LoadMarket loads or reloads one market
+func (r *MarketRegistry) LoadMarket(conditionID string) (MarketInfo, error) {
+	response, err := r.client.GetMarket(conditionID)
+	if err != nil {
+		return MarketInfo{}, fmt.Errorf("failed to load market: %w", err)
+	}
+
+	market := parseMarketInfo(response)
+	if market == nil {
+		return MarketInfo{}, fmt.Errorf("invalid market response for %s", conditionID)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.put(market)
+	return *market, nil
+}
+
+// AddGammaMarkets merges markets of the Gamma API, e.g. to resolve slugs, filling in the
+// fields missing from the known markets
+func (r *MarketRegistry) AddGammaMarkets(markets []types.GammaMarket) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, gamma := range markets {
+		if gamma.ConditionID == "" {
+			continue
+		}
+
+		market := &MarketInfo{ConditionID: gamma.ConditionID}
+		if known, ok := r.markets[gamma.ConditionID]; ok {
+			copied := *known
+			market = &copied
+		} else {
+			market.Active, market.Closed = gamma.Active, gamma.Closed
+		}
+
+		if market.Slug == "" {
+			market.Slug = gamma.Slug
+		}
+		if market.Question == "" {
+			market.Question = gamma.Question
+		}
+		if market.MinOrderSize == 0 {
+			market.MinOrderSize = gamma.OrderMinSize
+		}
+		if len(market.Tokens) == 0 {
+			for i, tokenID := range gamma.ClobTokenIDs {
+				token := OutcomeToken{TokenID: tokenID}
+				if i < len(gamma.Outcomes) {
+					token.Outcome = gamma.Outcomes[i]
+				}
+				market.Tokens = append(market.Tokens, token)
+			}
+		}
+		r.put(market)
+	}
+}
+
+// put stores a market, replacing the known one, and reports whether it is new
+// Called with r.mu held.
+func (r *MarketRegistry) put(market *MarketInfo) bool {
+	old, known := r.markets[market.ConditionID]
+	if known {
+		for _, token := range old.Tokens {
+			delete(r.tokens, token.TokenID)
+		}
+		if old.Slug != "" {
+			delete(r.slugs, old.Slug)
+		}
+	}
+
+	r.markets[market.ConditionID] = market
+	for _, token := range market.Tokens {
+		r.tokens[token.TokenID] = market
+		if market.TickSize != "" {
+			r.client.cache.SetTickSize(token.TokenID, market.TickSize)
+		}
+		r.client.cache.SetNegRisk(token.TokenID, market.NegRisk)
+	}
+	if market.Slug != "" {
+		r.slugs[market.Slug] = market
+	}
+	return !known
+}
+
+// Market returns a market by condition ID
+func (r *MarketRegistry) Market(conditionID string) (MarketInfo, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if market, ok := r.markets[conditionID]; ok {
+		return *market, true
+	}
+	return MarketInfo{}, false
+}
+
+// MarketBySlug returns a market by slug
+func (r *MarketRegistry) MarketBySlug(slug string) (MarketInfo, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if market, ok := r.slugs[slug]; ok {
+		return *market, true
+	}
+	return MarketInfo{}, false
+}
+
+// Token resolves a token to its outcome, complementary token and market
+func (r *MarketRegistry) Token(tokenID string) (TokenInfo, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	market, ok := r.tokens[tokenID]
+	if !ok {
+		return TokenInfo{}, false
+	}
+
+	info := TokenInfo{TokenID: tokenID, Market: *market}
+	for _, token := range market.Tokens {
+		if token.TokenID == tokenID {
+			info.Outcome = token.Outcome
+		} else if len(market.Tokens) == 2 {
+			info.Complement = token.TokenID
+		}
+	}
+	return info, true
+}
+
+// Complement returns the other outcome token of a binary market
+func (r *MarketRegistry) Complement(tokenID string) (string, bool) {
+	info, ok := r.Token(tokenID)
+	return info.Complement, ok && info.Complement != ""
+}
+
+// TickSize returns the tick size of a token
+func (r *MarketRegistry) TickSize(tokenID string) (types.TickSize, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if market, ok := r.tokens[tokenID]; ok && market.TickSize != "" {
+		return market.TickSize, true
+	}
+	return "", false
+}
+
+// NegRisk returns the neg risk flag of a token
+func (r *MarketRegistry) NegRisk(tokenID string) (bool, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if market, ok := r.tokens[tokenID]; ok {
+		return market.NegRisk, true
+	}
+	return false, false
+}
+
+// MinOrderSize returns the minimum order size of a token
+func (r *MarketRegistry) MinOrderSize(tokenID string) (float64, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if market, ok := r.tokens[tokenID]; ok && market.MinOrderSize > 0 {
+		return market.MinOrderSize, true
+	}
+	return 0, false
+}
+
+// Len returns the number of markets
+func (r *MarketRegistry) Len() int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return len(r.markets)
+}
+
+// Updated returns the time of the last load, or of the loaded snapshot
+func (r *MarketRegistry) Updated() time.Time {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.updated
+}
+
+// HandleEvent applies the tick size changes of a market channel event stream
+func (r *MarketRegistry) HandleEvent(event websocket.Event) {
+	if event.Type != websocket.EventTickSizeChange || event.TickSizeChange == nil {
+		return
+	}
+	update := event.TickSizeChange
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	market, ok := r.tokens[update.AssetID]
+	if !ok || update.NewTickSize == "" {
+		return
+	}
+	changed := *market
+	changed.TickSize = types.TickSize(update.NewTickSize)
+	r.put(&changed)
+}
+
+// Save writes a snapshot of the registry
+func (r *MarketRegistry) Save(w io.Writer) error {
+	r.mu.RLock()
+	snapshot := registrySnapshot{
+		Version:  registrySnapshotVersion,
+		Updated:  r.updated,
+		LastPage: r.lastPage,
+		Markets:  make([]MarketInfo, 0, len(r.markets)),
+	}
+	for _, market := range r.markets {
+		snapshot.Markets = append(snapshot.Markets, *market)
+	}
+	r.mu.RUnlock()
+
+	if err := json.NewEncoder(w).Encode(snapshot); err != nil {
+		return fmt.Errorf("failed to write registry snapshot: %w", err)
+	}
+	return nil
+}
+
+// Restore loads a snapshot written by Save, adding its markets to the registry
+// Refresh then only loads the markets listed after the snapshot was taken.
+func (r *MarketRegistry) Restore(rd io.Reader) error {
+	var snapshot registrySnapshot
+	if err := json.NewDecoder(rd).Decode(&snapshot); err != nil {
+		return fmt.Errorf("invalid registry snapshot: %w", err)
+	}
+	if snapshot.Version != registrySnapshotVersion {
+		return fmt.Errorf("unsupported registry snapshot version %d", snapshot.Version)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i := range snapshot.Markets {
+		r.put(&snapshot.Markets[i])
+	}
+	r.lastPage = snapshot.LastPage
+	r.updated = snapshot.Updated
+	return nil
+}
+
+// SaveFile atomically writes a snapshot of the registry to a file
+func (r *MarketRegistry) SaveFile(path string) error {
+	dir := filepath.Dir(path)
+	tmp, err := os.CreateTemp(dir, filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	tmpPath := tmp.Name()
+	defer os.Remove(tmpPath) // No-op once renamed
+
+	if err := r.Save(tmp); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write registry snapshot: %w", err)
+	}
+	return os.Rename(tmpPath, path)
+}
+
+// LoadFile restores a snapshot file written by SaveFile
+func (r *MarketRegistry) LoadFile(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open registry snapshot: %w", err)
+	}
+	defer file.Close()
+	return r.Restore(file)
+}
+
+// parseMarketInfo parses a CLOB market, nil without a condition ID
+// Based on: Polymarket CLOB API /markets response
+func parseMarketInfo(raw map[string]interface{}) *MarketInfo {
+	conditionID, _ := raw["condition_id"].(string)
+	if conditionID == "" {
+		return nil
+	}
+
+	market := &MarketInfo{ConditionID: conditionID}
+	market.QuestionID, _ = raw["question_id"].(string)
+	market.Slug, _ = raw["market_slug"].(string)
+	market.Question, _ = raw["question"].(string)
+	market.NegRisk, _ = raw["neg_risk"].(bool)
+	market.Active, _ = raw["active"].(bool)
+	market.Closed, _ = raw["closed"].(bool)
+	market.AcceptingOrders, _ = raw["accepting_orders"].(bool)
+
+	// Numbers or strings depending on the endpoint
+	tickSize := numberString(raw["minimum_tick_size"])
+	if tickSize == "" {
+		tickSize = numberString(raw["min_tick_size"])
+	}
+	market.TickSize = types.TickSize(tickSize)
+	if size := numberString(raw["minimum_order_size"]); size != "" {
+		market.MinOrderSize, _ = strconv.ParseFloat(size, 64)
+	}
+
+	if tokens, ok := raw["tokens"].([]interface{}); ok {
+		for _, item := range tokens {
+			token, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			tokenID, _ := token["token_id"].(string)
+			if tokenID == "" {
+				continue
+			}
+			outcome, _ := token["outcome"].(string)
+			market.Tokens = append(market.Tokens, OutcomeToken{TokenID: tokenID, Outcome: outcome})
+		}
+	}
+	return market
+}
+
+// numberString formats a JSON number or string field, empty when missing
+func numberString(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	return ""
+}
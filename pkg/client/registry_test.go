@@ -0,0 +1,253 @@
+package client_test
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/pooofdevelopment/go-clob-client/pkg/client"
+	"github.com/pooofdevelopment/go-clob-client/pkg/types"
+	"github.com/pooofdevelopment/go-clob-client/pkg/websocket"
+)
+
+// marketsServer serves the CLOB markets in pages of 2 and records the requested cursors
+type marketsServer struct {
+	mu      sync.Mutex
+	markets []map[string]interface{}
+	cursors []string
+	other   int // Requests to other endpoints
+}
+
+func (s *marketsServer) add(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	id := len(s.markets)
+	for i := 0; i < n; i++ {
+		s.markets = append(s.markets, map[string]interface{}{
+			"condition_id":       fmt.Sprintf("0xc%d", id+i),
+			"question":           fmt.Sprintf("Question %d?", id+i),
+			"market_slug":        fmt.Sprintf("market-%d", id+i),
+			"minimum_tick_size":  0.01,
+			"minimum_order_size": 5,
+			"neg_risk":           (id+i)%2 == 1,
+			"active":             true,
+			"tokens": []map[string]interface{}{
+				{"token_id": fmt.Sprintf("%d1", id+i), "outcome": "Yes"},
+				{"token_id": fmt.Sprintf("%d2", id+i), "outcome": "No"},
+			},
+		})
+	}
+}
+
+func (s *marketsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if r.URL.Path != types.GET_MARKETS {
+		s.other++
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	cursor := r.URL.Query().Get("next_cursor")
+	s.cursors = append(s.cursors, cursor)
+	decoded, _ := base64.StdEncoding.DecodeString(cursor)
+	offset, _ := strconv.Atoi(string(decoded))
+
+	end := offset + 2
+	if end > len(s.markets) {
+		end = len(s.markets)
+	}
+	next := types.EndCursor
+	if end < len(s.markets) {
+		next = base64.StdEncoding.EncodeToString([]byte(strconv.Itoa(end)))
+	}
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": s.markets[offset:end], "next_cursor": next})
+}
+
+func (s *marketsServer) takeCursors() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cursors := s.cursors
+	s.cursors = nil
+	return cursors
+}
+
+// TestMarketRegistry tests bulk loading, lookups and incremental refreshes
+func TestMarketRegistry(t *testing.T) {
+	fake := &marketsServer{}
+	fake.add(3)
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	clobClient, err := client.NewClobClient(server.URL, 137, "", nil, nil, nil)
+	if err != nil {
+		t.Fatalf("NewClobClient() failed: %v", err)
+	}
+	registry := client.NewMarketRegistry(clobClient)
+	if err := registry.Load(); err != nil {
+		t.Fatalf("Load() failed: %v", err)
+	}
+	if registry.Len() != 3 {
+		t.Fatalf("Len() = %d, want 3", registry.Len())
+	}
+	fake.takeCursors()
+
+	token, ok := registry.Token("11")
+	if !ok || token.Outcome != "Yes" || token.Complement != "12" || token.Market.ConditionID != "0xc1" {
+		t.Errorf("Token(11) = %+v", token)
+	}
+	if complement, ok := registry.Complement("12"); !ok || complement != "11" {
+		t.Errorf("Complement(12) = %s, want 11", complement)
+	}
+	if market, ok := registry.MarketBySlug("market-2"); !ok || market.ConditionID != "0xc2" || market.Question != "Question 2?" {
+		t.Errorf("MarketBySlug() = %+v", market)
+	}
+	if size, ok := registry.MinOrderSize("01"); !ok || size != 5 {
+		t.Errorf("MinOrderSize() = %v, want 5", size)
+	}
+	if negRisk, ok := registry.NegRisk("11"); !ok || !negRisk {
+		t.Error("NegRisk(11) should be true")
+	}
+	if _, ok := registry.Token("unknown"); ok {
+		t.Error("Token() of an unknown token should fail")
+	}
+
+	// Order metadata comes from the registry without requests
+	if tickSize, err := clobClient.GetTickSize("21"); err != nil || tickSize != "0.01" {
+		t.Errorf("GetTickSize() = %s, %v, want the registry tick size", tickSize, err)
+	}
+	fake.mu.Lock()
+	if fake.other != 0 {
+		t.Errorf("%d metadata requests, want none", fake.other)
+	}
+	fake.mu.Unlock()
+
+	// Refresh resumes from the last page
+	fake.add(2)
+	added, err := registry.Refresh()
+	if err != nil || added != 2 || registry.Len() != 5 {
+		t.Fatalf("Refresh() = %d, %v with %d markets, want 2 added", added, err, registry.Len())
+	}
+	if cursors := fake.takeCursors(); len(cursors) != 2 || cursors[0] != "Mg==" {
+		t.Errorf("Refresh() requested %v, want the pages from Mg==", cursors)
+	}
+
+	// Tick size changes from the market channel
+	registry.HandleEvent(websocket.Event{
+		Type:           websocket.EventTickSizeChange,
+		TickSizeChange: &websocket.TickSizeChangeUpdate{AssetID: "42", OldTickSize: "0.01", NewTickSize: "0.001"},
+	})
+	if tickSize, _ := registry.TickSize("41"); tickSize != "0.001" {
+		t.Errorf("TickSize() after the change = %s, want 0.001", tickSize)
+	}
+}
+
+// TestMarketRegistrySnapshot tests restoring a saved registry and refreshing it
+func TestMarketRegistrySnapshot(t *testing.T) {
+	fake := &marketsServer{}
+	fake.add(3)
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	clobClient, _ := client.NewClobClient(server.URL, 137, "", nil, nil, nil)
+	registry := client.NewMarketRegistry(clobClient)
+	if err := registry.Load(); err != nil {
+		t.Fatalf("Load() failed: %v", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "markets.json")
+	if err := registry.SaveFile(path); err != nil {
+		t.Fatalf("SaveFile() failed: %v", err)
+	}
+	fake.takeCursors()
+
+	restored := client.NewMarketRegistry(clobClient)
+	if err := restored.LoadFile(path); err != nil {
+		t.Fatalf("LoadFile() failed: %v", err)
+	}
+	if token, ok := restored.Token("21"); !ok || token.Complement != "22" || token.Market.TickSize != "0.01" {
+		t.Errorf("restored Token(21) = %+v", token)
+	}
+	if !restored.Updated().Equal(registry.Updated()) {
+		t.Errorf("Updated() = %v, want the snapshot time %v", restored.Updated(), registry.Updated())
+	}
+	if len(fake.takeCursors()) != 0 {
+		t.Error("restoring should not request markets")
+	}
+
+	fake.add(1)
+	if added, err := restored.Refresh(); err != nil || added != 1 {
+		t.Errorf("Refresh() after restore = %d, %v, want 1 added", added, err)
+	}
+	if cursors := fake.takeCursors(); len(cursors) != 1 || cursors[0] != "Mg==" {
+		t.Errorf("Refresh() after restore requested %v, want the last page Mg==", cursors)
+	}
+
+	if err := restored.Restore(strings.NewReader(`{"version":99,"markets":[]}`)); err == nil {
+		t.Error("Restore() of an unknown version should fail")
+	}
+}
+
+// TestMarketRegistryGamma tests merging Gamma markets
+func TestMarketRegistryGamma(t *testing.T) {
+	clobClient, _ := client.NewClobClient("http://127.0.0.1:0", 137, "", nil, nil, nil)
+	registry := client.NewMarketRegistry(clobClient)
+	registry.AddGammaMarkets([]types.GammaMarket{{
+		ConditionID:  "0xg",
+		Slug:         "will-it-rain",
+		Question:     "Will it rain?",
+		Outcomes:     []string{"Yes", "No"},
+		ClobTokenIDs: []string{"91", "92"},
+		OrderMinSize: 15,
+	}})
+
+	token, ok := registry.Token("92")
+	if !ok || token.Outcome != "No" || token.Complement != "91" || token.Market.Slug != "will-it-rain" {
+		t.Errorf("Token(92) = %+v", token)
+	}
+	if size, _ := registry.MinOrderSize("91"); size != 15 {
+		t.Errorf("MinOrderSize() = %v, want 15", size)
+	}
+}
+
+// TestMarketRegistryGammaOutcomes tests that markets from GetGammaMarkets keep their outcomes
+// apart from the description
+func TestMarketRegistryGammaOutcomes(t *testing.T) {
+	gammaMarkets := `[{"id":"7","conditionId":"0xg","slug":"will-it-rain","question":"Will it rain?",` +
+		`"description":"Resolves Yes if it rains.","outcomes":"[\"Yes\",\"No\"]","clobTokenIds":"[\"91\",\"92\"]","enableOrderBook":true}]`
+	httpClient := &http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": {"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(gammaMarkets)),
+			Request:    r,
+		}, nil
+	})}
+	clobClient, err := client.NewClobClientWithOptions("http://127.0.0.1:0", 137, "", nil, nil, nil, client.WithHTTPClient(httpClient))
+	if err != nil {
+		t.Fatalf("NewClobClientWithOptions() failed: %v", err)
+	}
+
+	markets, err := clobClient.GetGammaMarkets(nil)
+	if err != nil {
+		t.Fatalf("GetGammaMarkets() failed: %v", err)
+	}
+	if len(markets) != 1 || markets[0].Description != "Resolves Yes if it rains." {
+		t.Fatalf("GetGammaMarkets() = %+v", markets)
+	}
+
+	registry := client.NewMarketRegistry(clobClient)
+	registry.AddGammaMarkets(markets)
+	if token, ok := registry.Token("91"); !ok || token.Outcome != "Yes" {
+		t.Errorf("Token(91) = %+v", token)
+	}
+}
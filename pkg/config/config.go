@@ -52,4 +52,28 @@ func GetContractConfig(chainID int, negRisk bool) (*types.ContractConfig, error)
 	}
 	
 	return config, nil
-}
\ No newline at end of file
+}
+
+// GetProxyWalletConfig returns the proxy and Safe wallet factory configuration for the specified chain
+// Based on: builder-relayer-client/src/config/index.ts
+func GetProxyWalletConfig(chainID int) (*types.ProxyWalletConfig, error) {
+	configs := map[int]*types.ProxyWalletConfig{
+		137: { // Polygon mainnet
+			ProxyFactory:      "0xaB45c5A4B0c941a2F231C04C3f49182e1A254052",
+			ProxyInitCodeHash: "0xd21df8dc65880a8606f09fe0ce3df9b8869287ab0b058be05aa9e8af6330a00b",
+			SafeFactory:       "0xaacFeEa03eb1561C4e67d661e40682Bd20E3541b",
+			SafeInitCodeHash:  "0x2bce2127ff07fb632d16c8347c4ebf501f4841168bed00d9e6ef715ddb6fcecf",
+		},
+		80002: { // Amoy testnet (no proxy factory deployed)
+			SafeFactory:      "0xaacFeEa03eb1561C4e67d661e40682Bd20E3541b",
+			SafeInitCodeHash: "0x2bce2127ff07fb632d16c8347c4ebf501f4841168bed00d9e6ef715ddb6fcecf",
+		},
+	}
+
+	config := configs[chainID]
+	if config == nil {
+		return nil, fmt.Errorf("invalid chainID: %d", chainID)
+	}
+
+	return config, nil
+}
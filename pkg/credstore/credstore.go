@@ -0,0 +1,22 @@
+package credstore
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pooofdevelopment/go-clob-client/pkg/types"
+)
+
+// Store persists API credentials keyed by signer address and chain ID
+// Load returns errors.ErrCredentialsNotFound when no credentials are stored for the key
+type Store interface {
+	Load(address string, chainID int) (*types.ApiCreds, error)
+	Save(address string, chainID int, creds *types.ApiCreds) error
+	Delete(address string, chainID int) error
+}
+
+// entryKey builds the lookup key for an address and chain ID
+// Addresses are compared case-insensitively
+func entryKey(address string, chainID int) string {
+	return fmt.Sprintf("%s:%d", strings.ToLower(address), chainID)
+}
@@ -0,0 +1,258 @@
+package credstore
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/pooofdevelopment/go-clob-client/pkg/errors"
+	"github.com/pooofdevelopment/go-clob-client/pkg/types"
+	"golang.org/x/crypto/scrypt"
+)
+
+// File format and key derivation parameters
+const (
+	fileVersion = 1
+	saltSize    = 16
+	keySize     = 32 // AES-256
+	scryptN     = 1 << 15
+	scryptR     = 8
+	scryptP     = 1
+)
+
+// fileContents is the on-disk layout of a FileStore
+// The scrypt salt is shared by all entries, each entry is sealed separately with AES-GCM
+type fileContents struct {
+	Version int                    `json:"version"`
+	KDF     string                 `json:"kdf"`
+	Salt    []byte                 `json:"salt"`
+	Entries map[string]sealedCreds `json:"entries"`
+}
+
+// sealedCreds is one encrypted credentials entry
+type sealedCreds struct {
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// credsRecord is the plaintext of an entry, with the secrets revealed
+type credsRecord struct {
+	ApiKey        string `json:"api_key"`
+	ApiSecret     string `json:"api_secret"`
+	ApiPassphrase string `json:"api_passphrase"`
+}
+
+// FileStore is a Store keeping credentials encrypted in a single file
+// Entries are encrypted with AES-256-GCM under a key derived from a passphrase with scrypt,
+// and bound to their address and chain ID so they cannot be swapped between entries
+type FileStore struct {
+	path       string
+	passphrase []byte
+
+	mu   sync.Mutex
+	salt []byte
+	key  []byte
+}
+
+// NewFileStore creates a store backed by the file at path, encrypted with the given passphrase
+// The file is created on the first Save
+func NewFileStore(path string, passphrase []byte) (*FileStore, error) {
+	if len(passphrase) == 0 {
+		return nil, fmt.Errorf("passphrase is required")
+	}
+
+	return &FileStore{
+		path:       path,
+		passphrase: passphrase,
+	}, nil
+}
+
+// Load decrypts the credentials stored for an address and chain ID
+func (s *FileStore) Load(address string, chainID int) (*types.ApiCreds, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	contents, err := s.read()
+	if err != nil {
+		return nil, err
+	}
+
+	key := entryKey(address, chainID)
+	entry, ok := contents.Entries[key]
+	if !ok {
+		return nil, errors.ErrCredentialsNotFound
+	}
+
+	gcm, err := s.cipher(contents.Salt)
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext, err := gcm.Open(nil, entry.Nonce, entry.Ciphertext, []byte(key))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt credentials (wrong passphrase or corrupted file): %w", err)
+	}
+
+	var record credsRecord
+	if err := json.Unmarshal(plaintext, &record); err != nil {
+		return nil, fmt.Errorf("failed to parse credentials: %w", err)
+	}
+
+	return &types.ApiCreds{
+		ApiKey:        record.ApiKey,
+		ApiSecret:     types.Secret(record.ApiSecret),
+		ApiPassphrase: types.Secret(record.ApiPassphrase),
+	}, nil
+}
+
+// Save encrypts and stores the credentials for an address and chain ID
+func (s *FileStore) Save(address string, chainID int, creds *types.ApiCreds) error {
+	if creds == nil {
+		return fmt.Errorf("credentials are required")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	contents, err := s.read()
+	if err != nil {
+		return err
+	}
+
+	gcm, err := s.cipher(contents.Salt)
+	if err != nil {
+		return err
+	}
+
+	plaintext, err := json.Marshal(credsRecord{
+		ApiKey:        creds.ApiKey,
+		ApiSecret:     creds.ApiSecret.Reveal(),
+		ApiPassphrase: creds.ApiPassphrase.Reveal(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal credentials: %w", err)
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	key := entryKey(address, chainID)
+	contents.Entries[key] = sealedCreds{
+		Nonce:      nonce,
+		Ciphertext: gcm.Seal(nil, nonce, plaintext, []byte(key)),
+	}
+
+	return s.write(contents)
+}
+
+// Delete removes the credentials stored for an address and chain ID
+func (s *FileStore) Delete(address string, chainID int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	contents, err := s.read()
+	if err != nil {
+		return err
+	}
+
+	key := entryKey(address, chainID)
+	if _, ok := contents.Entries[key]; !ok {
+		return nil
+	}
+	delete(contents.Entries, key)
+
+	return s.write(contents)
+}
+
+// read loads the store file, returning empty contents with a fresh salt if it does not exist yet
+func (s *FileStore) read() (*fileContents, error) {
+	data, err := os.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		salt := make([]byte, saltSize)
+		if _, err := rand.Read(salt); err != nil {
+			return nil, fmt.Errorf("failed to generate salt: %w", err)
+		}
+		return &fileContents{
+			Version: fileVersion,
+			KDF:     "scrypt",
+			Salt:    salt,
+			Entries: make(map[string]sealedCreds),
+		}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read credential store: %w", err)
+	}
+
+	var contents fileContents
+	if err := json.Unmarshal(data, &contents); err != nil {
+		return nil, fmt.Errorf("failed to parse credential store: %w", err)
+	}
+	if contents.Version != fileVersion {
+		return nil, fmt.Errorf("unsupported credential store version: %d", contents.Version)
+	}
+	if contents.Entries == nil {
+		contents.Entries = make(map[string]sealedCreds)
+	}
+
+	return &contents, nil
+}
+
+// write atomically replaces the store file with owner-only permissions
+func (s *FileStore) write(contents *fileContents) error {
+	data, err := json.MarshalIndent(contents, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal credential store: %w", err)
+	}
+
+	dir := filepath.Dir(s.path)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return fmt.Errorf("failed to create credential store directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(dir, filepath.Base(s.path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	tmpPath := tmp.Name()
+	defer os.Remove(tmpPath) // No-op once renamed
+
+	if err := tmp.Chmod(0o600); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("failed to set credential store permissions: %w", err)
+	}
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("failed to write credential store: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write credential store: %w", err)
+	}
+
+	return os.Rename(tmpPath, s.path)
+}
+
+// cipher returns the AES-GCM cipher for the given salt, deriving the key on first use
+func (s *FileStore) cipher(salt []byte) (cipher.AEAD, error) {
+	if s.key == nil || string(s.salt) != string(salt) {
+		key, err := scrypt.Key(s.passphrase, salt, scryptN, scryptR, scryptP, keySize)
+		if err != nil {
+			return nil, fmt.Errorf("failed to derive encryption key: %w", err)
+		}
+		s.key = key
+		s.salt = salt
+	}
+
+	block, err := aes.NewCipher(s.key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,70 @@
+package credstore_test
+
+import (
+	stderrors "errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pooofdevelopment/go-clob-client/pkg/credstore"
+	"github.com/pooofdevelopment/go-clob-client/pkg/errors"
+	"github.com/pooofdevelopment/go-clob-client/pkg/types"
+)
+
+// TestFileStore tests saving, loading and deleting encrypted credentials
+func TestFileStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "creds.json")
+	store, err := credstore.NewFileStore(path, []byte("correct horse"))
+	if err != nil {
+		t.Fatalf("NewFileStore() failed: %v", err)
+	}
+
+	address := "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"
+	if _, err := store.Load(address, 137); !stderrors.Is(err, errors.ErrCredentialsNotFound) {
+		t.Errorf("Load() on empty store error = %v, want ErrCredentialsNotFound", err)
+	}
+
+	creds := &types.ApiCreds{ApiKey: "key", ApiSecret: "secret", ApiPassphrase: "passphrase"}
+	if err := store.Save(address, 137, creds); err != nil {
+		t.Fatalf("Save() failed: %v", err)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("Stat() failed: %v", err)
+	}
+	if info.Mode().Perm() != 0o600 {
+		t.Errorf("store file permissions = %v, want 0600", info.Mode().Perm())
+	}
+	data, _ := os.ReadFile(path)
+	for _, secret := range []string{"secret", "passphrase"} {
+		if strings.Contains(string(data), `"`+secret+`"`) {
+			t.Errorf("store file contains plaintext %q", secret)
+		}
+	}
+
+	// Addresses are matched case-insensitively, chain IDs are not
+	loaded, err := store.Load("0xf39fd6e51aad88f6f4ce6ab8827279cfffb92266", 137)
+	if err != nil {
+		t.Fatalf("Load() failed: %v", err)
+	}
+	if *loaded != *creds {
+		t.Errorf("Load() = %+v, want %+v", loaded, creds)
+	}
+	if _, err := store.Load(address, 80002); !stderrors.Is(err, errors.ErrCredentialsNotFound) {
+		t.Errorf("Load() for other chain error = %v, want ErrCredentialsNotFound", err)
+	}
+
+	wrong, _ := credstore.NewFileStore(path, []byte("wrong"))
+	if _, err := wrong.Load(address, 137); err == nil || stderrors.Is(err, errors.ErrCredentialsNotFound) {
+		t.Errorf("Load() with wrong passphrase error = %v, want decryption error", err)
+	}
+
+	if err := store.Delete(address, 137); err != nil {
+		t.Fatalf("Delete() failed: %v", err)
+	}
+	if _, err := store.Load(address, 137); !stderrors.Is(err, errors.ErrCredentialsNotFound) {
+		t.Errorf("Load() after Delete() error = %v, want ErrCredentialsNotFound", err)
+	}
+}
@@ -1,6 +1,9 @@
 package errors
 
-import "fmt"
+import (
+	stderrors "errors"
+	"fmt"
+)
 
 // PolyException represents a custom exception for the CLOB client
 // Based on: py-clob-client-main/py_clob_client/exceptions.py:1-5
@@ -29,8 +32,30 @@ var (
 	ErrInvalidPrice      = NewPolyException("Invalid price")
 	ErrNoOrderbook       = NewPolyException("No orderbook available")
 	ErrNoMatch           = NewPolyException("No match found")
+	ErrAccountNotFound   = NewPolyException("Account not found")
+	ErrCredentialsNotFound = NewPolyException("Credentials not found")
+	ErrApiKeyRejected      = NewPolyException("API key rejected")
 )
 
+// HTTPError is returned for API responses with a non-2xx status
+type HTTPError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *HTTPError) Error() string {
+	return fmt.Sprintf("HTTP %d: %s", e.StatusCode, e.Message)
+}
+
+// StatusCode returns the status of the HTTPError in the chain of err, if any
+func StatusCode(err error) (int, bool) {
+	var httpErr *HTTPError
+	if stderrors.As(err, &httpErr) {
+		return httpErr.StatusCode, true
+	}
+	return 0, false
+}
+
 // NewInvalidTickSizeError creates a tick size validation error
 // Based on: py-clob-client-main/py_clob_client/client.py:325-332
 func NewInvalidTickSizeError(tickSize, minTickSize string) error {
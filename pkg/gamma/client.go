@@ -0,0 +1,167 @@
+// Package gamma is a client for the Polymarket Gamma API, the public metadata API of
+// markets, events, tags, series, sports, comments and search
+// Based on: Polymarket Gamma API documentation (https://docs.polymarket.com)
+package gamma
+
+import (
+	"iter"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/pooofdevelopment/go-clob-client/pkg/httpclient"
+)
+
+// DefaultHost is the Gamma API endpoint
+const DefaultHost = "https://gamma-api.polymarket.com"
+
+// DefaultPageSize is the page size of the iterators when ListParams.Limit is not set
+const DefaultPageSize = 100
+
+// Client is a Gamma API client
+// It is safe for concurrent use.
+type Client struct {
+	host       string
+	httpClient *httpclient.Client
+}
+
+// Option is a functional option for configuring the Client
+// WithTransport replaces the rate limiter and logger set by earlier options, so pass it first.
+type Option func(*Client)
+
+// WithHost returns an Option that sets the API host, e.g. a local stand-in in tests
+func WithHost(host string) Option {
+	return func(c *Client) {
+		c.host = strings.TrimSuffix(host, "/")
+	}
+}
+
+// WithHTTPClient returns an Option that sets a custom HTTP client
+// It keeps the rate limiter and logger of the other options, whatever their order.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient.SetHTTPClient(httpClient)
+	}
+}
+
+// WithTransport returns an Option that shares an existing transport, with its rate limiter
+// and logger, e.g. the one of a ClobClient
+// The client keeps a copy, so WithRateLimiter and WithLogger do not change httpClient.
+func WithTransport(httpClient *httpclient.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient.Clone()
+	}
+}
+
+// WithRateLimiter returns an Option that rate limits all requests of the client
+// It must come after WithTransport, which replaces it.
+func WithRateLimiter(limiter *httpclient.RateLimiter) Option {
+	return func(c *Client) {
+		c.httpClient.SetRateLimiter(limiter)
+	}
+}
+
+// WithLogger returns an Option that sets the structured logger of the requests
+// It must come after WithTransport, which replaces it.
+func WithLogger(logger *slog.Logger) Option {
+	return func(c *Client) {
+		c.httpClient.SetLogger(logger)
+	}
+}
+
+// NewClient creates a Gamma API client for DefaultHost
+func NewClient(opts ...Option) *Client {
+	c := &Client{
+		host:       DefaultHost,
+		httpClient: httpclient.NewClient(),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Host returns the API host
+func (c *Client) Host() string {
+	return c.host
+}
+
+// get decodes the response of a GET request into out
+func (c *Client) get(path string, q url.Values, out interface{}) error {
+	u := c.host + path
+	if len(q) > 0 {
+		u += "?" + q.Encode()
+	}
+	return c.httpClient.GetJSON(u, nil, out)
+}
+
+// paginate iterates over the items of offset-paginated pages, starting at page.Offset
+// The iteration stops after an empty page, or after yielding an error. A short page does not
+// end it, as the server may cap the page size below page.Limit.
+func paginate[T any](page ListParams, fetch func(ListParams) ([]T, error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		if page.Limit <= 0 {
+			page.Limit = DefaultPageSize
+		}
+		for {
+			items, err := fetch(page)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			if len(items) == 0 {
+				return
+			}
+			page.Offset += len(items)
+		}
+	}
+}
+
+// query builds the query string of a request, skipping unset values
+type query url.Values
+
+func (q query) str(key, value string) {
+	if value != "" {
+		url.Values(q).Set(key, value)
+	}
+}
+
+func (q query) strs(key string, values []string) {
+	for _, value := range values {
+		url.Values(q).Add(key, value)
+	}
+}
+
+func (q query) int(key string, value int) {
+	if value > 0 {
+		url.Values(q).Set(key, strconv.Itoa(value))
+	}
+}
+
+func (q query) float(key string, value float64) {
+	if value > 0 {
+		url.Values(q).Set(key, strconv.FormatFloat(value, 'f', -1, 64))
+	}
+}
+
+func (q query) bool(key string, value *bool) {
+	if value != nil {
+		url.Values(q).Set(key, strconv.FormatBool(*value))
+	}
+}
+
+// list adds the common list parameters
+func (q query) list(params ListParams) {
+	q.int("limit", params.Limit)
+	q.int("offset", params.Offset)
+	q.str("order", params.Order)
+	q.bool("ascending", params.Ascending)
+}
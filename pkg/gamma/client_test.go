@@ -0,0 +1,257 @@
+package gamma_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/pooofdevelopment/go-clob-client/pkg/gamma"
+	"github.com/pooofdevelopment/go-clob-client/pkg/httpclient"
+)
+
+// gammaServer is a local stand-in of the Gamma API that records the requested queries
+type gammaServer struct {
+	mu       sync.Mutex
+	queries  map[string][]url.Values
+	markets  int
+	maxLimit int // Page size cap, 0 for none
+}
+
+func (s *gammaServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	if s.queries == nil {
+		s.queries = map[string][]url.Values{}
+	}
+	s.queries[r.URL.Path] = append(s.queries[r.URL.Path], r.URL.Query())
+	s.mu.Unlock()
+
+	q := r.URL.Query()
+	switch r.URL.Path {
+	case "/markets":
+		offset, _ := strconv.Atoi(q.Get("offset"))
+		limit, _ := strconv.Atoi(q.Get("limit"))
+		if s.maxLimit > 0 && limit > s.maxLimit {
+			limit = s.maxLimit
+		}
+		var page []string
+		for i := offset; i < offset+limit && i < s.markets; i++ {
+			// IDs, numbers and lists in the mixed encodings of the API
+			page = append(page, fmt.Sprintf(`{"id":"%d","conditionId":"0x%d","outcomes":"[\"Yes\",\"No\"]",`+
+				`"clobTokenIds":["%d1","%d2"],"volumeNum":%d.5,"bestBid":"0.4","bestAsk":"","gameId":%d}`, i, i, i, i, i, i))
+		}
+		fmt.Fprintf(w, "[%s]", strings.Join(page, ","))
+	case "/events/slug/election":
+		fmt.Fprint(w, `{"id":7,"slug":"election","title":"Election","negRisk":true,"liquidity":"1200.5",`+
+			`"markets":[{"id":"70","outcomePrices":"[\"0.6\",\"0.4\"]"}],"tags":[{"id":"2","label":"Politics"}]}`)
+	case "/public-search":
+		page, _ := strconv.Atoi(q.Get("page"))
+		fmt.Fprintf(w, `{"events":[{"id":"%d"}],"tags":[],"profiles":[],"pagination":{"hasMore":%t,"totalResults":3}}`,
+			page, page < 3)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		_ = json.NewEncoder(w).Encode(map[string]string{"error": "not found"})
+	}
+}
+
+func (s *gammaServer) takeQueries(path string) []url.Values {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	queries := s.queries[path]
+	delete(s.queries, path)
+	return queries
+}
+
+// TestGammaClient tests the filters and the decoding of the Gamma models
+func TestGammaClient(t *testing.T) {
+	fake := &gammaServer{markets: 3}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+	client := gamma.NewClient(gamma.WithHost(server.URL))
+
+	active := true
+	markets, err := client.GetMarkets(&gamma.MarketsParams{
+		ListParams:   gamma.ListParams{Limit: 2, Order: "volume24hr", Ascending: new(bool)},
+		ClobTokenIDs: []string{"11", "12"},
+		Active:       &active,
+		VolumeNumMin: 1000,
+		TagID:        "2",
+	})
+	if err != nil {
+		t.Fatalf("GetMarkets() failed: %v", err)
+	}
+	queries := fake.takeQueries("/markets")
+	if len(queries) != 1 {
+		t.Fatalf("%d requests, want 1", len(queries))
+	}
+	want := url.Values{
+		"limit": {"2"}, "order": {"volume24hr"}, "ascending": {"false"}, "clob_token_ids": {"11", "12"},
+		"active": {"true"}, "volume_num_min": {"1000"}, "tag_id": {"2"},
+	}
+	if queries[0].Encode() != want.Encode() {
+		t.Errorf("query = %s, want %s", queries[0].Encode(), want.Encode())
+	}
+
+	if len(markets) != 2 {
+		t.Fatalf("GetMarkets() returned %d markets, want 2", len(markets))
+	}
+	market := markets[1]
+	if market.ID != "1" || market.GameID != "1" || market.ConditionID != "0x1" {
+		t.Errorf("market IDs = %+v", market)
+	}
+	if len(market.Outcomes) != 2 || market.Outcomes[1] != "No" || len(market.ClobTokenIDs) != 2 || market.ClobTokenIDs[0] != "11" {
+		t.Errorf("market lists = %v, %v", market.Outcomes, market.ClobTokenIDs)
+	}
+	if market.VolumeNum != 1.5 || market.BestBid != 0.4 || market.BestAsk != 0 {
+		t.Errorf("market numbers = %v, %v, %v", market.VolumeNum, market.BestBid, market.BestAsk)
+	}
+
+	event, err := client.GetEventBySlug("election")
+	if err != nil {
+		t.Fatalf("GetEventBySlug() failed: %v", err)
+	}
+	if event.ID != "7" || !event.NegRisk || event.Liquidity != 1200.5 || len(event.Tags) != 1 || event.Tags[0].Label != "Politics" {
+		t.Errorf("event = %+v", event)
+	}
+	if len(event.Markets) != 1 || len(event.Markets[0].OutcomePrices) != 2 || event.Markets[0].OutcomePrices[0] != "0.6" {
+		t.Errorf("event markets = %+v", event.Markets)
+	}
+
+	if _, err := client.GetTagBySlug("unknown"); err == nil || !strings.Contains(err.Error(), "HTTP 404: not found") {
+		t.Errorf("GetTagBySlug() of an unknown tag = %v, want the API error", err)
+	}
+	if _, err := client.Search(gamma.SearchParams{}); err == nil {
+		t.Error("Search() without a query should fail")
+	}
+}
+
+// TestGammaIterators tests paging through the results with iterators
+func TestGammaIterators(t *testing.T) {
+	fake := &gammaServer{markets: 5}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+	client := gamma.NewClient(gamma.WithHost(server.URL))
+
+	var ids []string
+	for market, err := range client.AllMarkets(gamma.MarketsParams{ListParams: gamma.ListParams{Limit: 2}}) {
+		if err != nil {
+			t.Fatalf("AllMarkets() failed: %v", err)
+		}
+		ids = append(ids, string(market.ID))
+	}
+	if strings.Join(ids, ",") != "0,1,2,3,4" {
+		t.Errorf("AllMarkets() = %v, want all the markets", ids)
+	}
+	var offsets []string
+	for _, q := range fake.takeQueries("/markets") {
+		offsets = append(offsets, q.Get("offset"))
+	}
+	if strings.Join(offsets, ",") != ",2,4,5" {
+		t.Errorf("offsets = %v, want pages from 0, 2 and 4 and an empty page from 5", offsets)
+	}
+
+	// Breaking out of the loop stops the requests
+	for range client.AllMarkets(gamma.MarketsParams{ListParams: gamma.ListParams{Limit: 2}}) {
+		break
+	}
+	if queries := fake.takeQueries("/markets"); len(queries) != 1 {
+		t.Errorf("%d requests after a break, want 1", len(queries))
+	}
+
+	// The default page size applies when no limit is set
+	count := 0
+	for _, err := range client.AllMarkets(gamma.MarketsParams{}) {
+		if err != nil {
+			t.Fatalf("AllMarkets() failed: %v", err)
+		}
+		count++
+	}
+	if queries := fake.takeQueries("/markets"); count != 5 || len(queries) != 2 || queries[0].Get("limit") != "100" {
+		t.Errorf("AllMarkets() without a limit = %d markets in %v", count, queries)
+	}
+
+	// Pages cut short by the server do not end the iteration
+	fake.mu.Lock()
+	fake.maxLimit = 2
+	fake.mu.Unlock()
+	count = 0
+	for _, err := range client.AllMarkets(gamma.MarketsParams{ListParams: gamma.ListParams{Limit: 4}}) {
+		if err != nil {
+			t.Fatalf("AllMarkets() failed: %v", err)
+		}
+		count++
+	}
+	if count != 5 {
+		t.Errorf("AllMarkets() with a capped page size = %d markets, want 5", count)
+	}
+	fake.takeQueries("/markets")
+
+	// Errors end the iteration
+	errs := 0
+	for _, err := range client.AllTags(gamma.TagsParams{}) {
+		if err == nil {
+			t.Fatal("AllTags() against a missing endpoint should fail")
+		}
+		errs++
+	}
+	if errs != 1 {
+		t.Errorf("AllTags() yielded %d errors, want 1", errs)
+	}
+
+	var pages []string
+	for results, err := range client.SearchPages(gamma.SearchParams{Query: "election", LimitPerType: 1}) {
+		if err != nil {
+			t.Fatalf("SearchPages() failed: %v", err)
+		}
+		pages = append(pages, string(results.Events[0].ID))
+	}
+	if strings.Join(pages, ",") != "1,2,3" {
+		t.Errorf("SearchPages() = %v, want the pages while more results remain", pages)
+	}
+	if queries := fake.takeQueries("/public-search"); len(queries) != 3 || queries[0].Get("q") != "election" || queries[0].Get("limit_per_type") != "1" {
+		t.Errorf("search queries = %v", queries)
+	}
+}
+
+// TestGammaSharedTransport tests that options do not change a shared transport
+func TestGammaSharedTransport(t *testing.T) {
+	server := httptest.NewServer(&gammaServer{markets: 1})
+	defer server.Close()
+
+	var logs bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	shared := httpclient.NewClient()
+	client := gamma.NewClient(gamma.WithHost(server.URL), gamma.WithTransport(shared), gamma.WithLogger(logger))
+
+	if _, err := client.GetMarkets(nil); err != nil {
+		t.Fatalf("GetMarkets() failed: %v", err)
+	}
+	if !strings.Contains(logs.String(), "/markets") {
+		t.Errorf("logs = %q, want the Gamma request", logs.String())
+	}
+
+	logs.Reset()
+	var markets []gamma.Market
+	if err := shared.GetJSON(server.URL+"/markets", nil, &markets); err != nil {
+		t.Fatalf("GetJSON() failed: %v", err)
+	}
+	if logs.Len() != 0 {
+		t.Errorf("the shared transport logged %q, want its own logger kept", logs.String())
+	}
+
+	// A custom HTTP client keeps the logger set before it
+	client = gamma.NewClient(gamma.WithHost(server.URL), gamma.WithLogger(logger), gamma.WithHTTPClient(server.Client()))
+	if _, err := client.GetMarkets(nil); err != nil {
+		t.Fatalf("GetMarkets() failed: %v", err)
+	}
+	if !strings.Contains(logs.String(), "/markets") {
+		t.Errorf("logs = %q, want the request logged", logs.String())
+	}
+}
@@ -0,0 +1,78 @@
+package gamma
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"net/url"
+)
+
+// GetComments returns a page of comments matching the params
+func (c *Client) GetComments(params *CommentsParams) ([]Comment, error) {
+	var comments []Comment
+	if err := c.get("/comments", url.Values(params.values()), &comments); err != nil {
+		return nil, fmt.Errorf("failed to get comments: %w", err)
+	}
+	return comments, nil
+}
+
+// AllComments iterates over all the comments matching the params, page by page
+func (c *Client) AllComments(params CommentsParams) iter.Seq2[Comment, error] {
+	return paginate(params.ListParams, func(page ListParams) ([]Comment, error) {
+		params.ListParams = page
+		return c.GetComments(&params)
+	})
+}
+
+// GetCommentThread returns a comment and its replies
+func (c *Client) GetCommentThread(id string) ([]Comment, error) {
+	var comments []Comment
+	if err := c.get("/comments/"+url.PathEscape(id), nil, &comments); err != nil {
+		return nil, fmt.Errorf("failed to get comment %s: %w", id, err)
+	}
+	return comments, nil
+}
+
+// GetUserComments returns a page of the comments of a user
+func (c *Client) GetUserComments(address string, params ListParams) ([]Comment, error) {
+	q := query{}
+	q.list(params)
+	var comments []Comment
+	if err := c.get("/comments/user_address/"+url.PathEscape(address), url.Values(q), &comments); err != nil {
+		return nil, fmt.Errorf("failed to get comments of %s: %w", address, err)
+	}
+	return comments, nil
+}
+
+// Search searches events, tags and profiles
+func (c *Client) Search(params SearchParams) (*SearchResults, error) {
+	if params.Query == "" {
+		return nil, errors.New("search query is required")
+	}
+	var results SearchResults
+	if err := c.get("/public-search", url.Values(params.values()), &results); err != nil {
+		return nil, fmt.Errorf("failed to search %q: %w", params.Query, err)
+	}
+	return &results, nil
+}
+
+// SearchPages iterates over the result pages of a search, starting at params.Page,
+// while the API reports more results
+func (c *Client) SearchPages(params SearchParams) iter.Seq2[*SearchResults, error] {
+	return func(yield func(*SearchResults, error) bool) {
+		if params.Page <= 0 {
+			params.Page = 1
+		}
+		for {
+			results, err := c.Search(params)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if !yield(results, nil) || !results.Pagination.HasMore {
+				return
+			}
+			params.Page++
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package gamma
+
+import (
+	"fmt"
+	"iter"
+	"net/url"
+)
+
+// GetMarkets returns a page of markets matching the params
+func (c *Client) GetMarkets(params *MarketsParams) ([]Market, error) {
+	var markets []Market
+	if err := c.get("/markets", url.Values(params.values()), &markets); err != nil {
+		return nil, fmt.Errorf("failed to get markets: %w", err)
+	}
+	return markets, nil
+}
+
+// AllMarkets iterates over all the markets matching the params, page by page
+func (c *Client) AllMarkets(params MarketsParams) iter.Seq2[Market, error] {
+	return paginate(params.ListParams, func(page ListParams) ([]Market, error) {
+		params.ListParams = page
+		return c.GetMarkets(&params)
+	})
+}
+
+// GetMarket returns a market by ID
+func (c *Client) GetMarket(id string) (*Market, error) {
+	var market Market
+	if err := c.get("/markets/"+url.PathEscape(id), nil, &market); err != nil {
+		return nil, fmt.Errorf("failed to get market %s: %w", id, err)
+	}
+	return &market, nil
+}
+
+// GetMarketBySlug returns a market by slug
+func (c *Client) GetMarketBySlug(slug string) (*Market, error) {
+	var market Market
+	if err := c.get("/markets/slug/"+url.PathEscape(slug), nil, &market); err != nil {
+		return nil, fmt.Errorf("failed to get market %s: %w", slug, err)
+	}
+	return &market, nil
+}
+
+// GetMarketTags returns the tags of a market
+func (c *Client) GetMarketTags(id string) ([]Tag, error) {
+	var tags []Tag
+	if err := c.get("/markets/"+url.PathEscape(id)+"/tags", nil, &tags); err != nil {
+		return nil, fmt.Errorf("failed to get tags of market %s: %w", id, err)
+	}
+	return tags, nil
+}
+
+// GetEvents returns a page of events matching the params
+func (c *Client) GetEvents(params *EventsParams) ([]Event, error) {
+	var events []Event
+	if err := c.get("/events", url.Values(params.values()), &events); err != nil {
+		return nil, fmt.Errorf("failed to get events: %w", err)
+	}
+	return events, nil
+}
+
+// AllEvents iterates over all the events matching the params, page by page
+func (c *Client) AllEvents(params EventsParams) iter.Seq2[Event, error] {
+	return paginate(params.ListParams, func(page ListParams) ([]Event, error) {
+		params.ListParams = page
+		return c.GetEvents(&params)
+	})
+}
+
+// GetEvent returns an event by ID
+func (c *Client) GetEvent(id string) (*Event, error) {
+	var event Event
+	if err := c.get("/events/"+url.PathEscape(id), nil, &event); err != nil {
+		return nil, fmt.Errorf("failed to get event %s: %w", id, err)
+	}
+	return &event, nil
+}
+
+// GetEventBySlug returns an event by slug
+func (c *Client) GetEventBySlug(slug string) (*Event, error) {
+	var event Event
+	if err := c.get("/events/slug/"+url.PathEscape(slug), nil, &event); err != nil {
+		return nil, fmt.Errorf("failed to get event %s: %w", slug, err)
+	}
+	return &event, nil
+}
+
+// GetEventTags returns the tags of an event
+func (c *Client) GetEventTags(id string) ([]Tag, error) {
+	var tags []Tag
+	if err := c.get("/events/"+url.PathEscape(id)+"/tags", nil, &tags); err != nil {
+		return nil, fmt.Errorf("failed to get tags of event %s: %w", id, err)
+	}
+	return tags, nil
+}
+
+// GetSeries returns a page of series matching the params
+func (c *Client) GetSeries(params *SeriesParams) ([]Series, error) {
+	var series []Series
+	if err := c.get("/series", url.Values(params.values()), &series); err != nil {
+		return nil, fmt.Errorf("failed to get series: %w", err)
+	}
+	return series, nil
+}
+
+// AllSeries iterates over all the series matching the params, page by page
+func (c *Client) AllSeries(params SeriesParams) iter.Seq2[Series, error] {
+	return paginate(params.ListParams, func(page ListParams) ([]Series, error) {
+		params.ListParams = page
+		return c.GetSeries(&params)
+	})
+}
+
+// GetSeriesByID returns a series by ID
+func (c *Client) GetSeriesByID(id string) (*Series, error) {
+	var series Series
+	if err := c.get("/series/"+url.PathEscape(id), nil, &series); err != nil {
+		return nil, fmt.Errorf("failed to get series %s: %w", id, err)
+	}
+	return &series, nil
+}
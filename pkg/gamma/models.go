@@ -0,0 +1,336 @@
+package gamma
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// ID is an identifier the API returns either as a string or as a number
+type ID string
+
+// UnmarshalJSON accepts a JSON string or number
+func (id *ID) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*id = ""
+		return nil
+	}
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		*id = ID(s)
+		return nil
+	}
+	var n json.Number
+	if err := json.Unmarshal(data, &n); err != nil {
+		return fmt.Errorf("invalid id %s: %w", data, err)
+	}
+	*id = ID(n.String())
+	return nil
+}
+
+// Float is a number the API returns either as a number or as a string
+// Empty strings and null decode as 0.
+type Float float64
+
+// UnmarshalJSON accepts a JSON number, a numeric string, "" or null
+func (f *Float) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*f = 0
+		return nil
+	}
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		if s == "" {
+			*f = 0
+			return nil
+		}
+		v, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return fmt.Errorf("invalid number %q: %w", s, err)
+		}
+		*f = Float(v)
+		return nil
+	}
+	var v float64
+	if err := json.Unmarshal(data, &v); err != nil {
+		return fmt.Errorf("invalid number %s: %w", data, err)
+	}
+	*f = Float(v)
+	return nil
+}
+
+// StringList is a list the API returns either as an array or as a JSON-encoded array,
+// e.g. the outcomes and token IDs of a market
+type StringList []string
+
+// UnmarshalJSON accepts a JSON array, a string holding a JSON array, "" or null
+func (l *StringList) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*l = nil
+		return nil
+	}
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		if s == "" {
+			*l = nil
+			return nil
+		}
+		data = []byte(s)
+	}
+	var values []interface{}
+	if err := json.Unmarshal(data, &values); err != nil {
+		return fmt.Errorf("invalid list %s: %w", data, err)
+	}
+	list := make(StringList, len(values))
+	for i, value := range values {
+		switch v := value.(type) {
+		case string:
+			list[i] = v
+		default:
+			list[i] = fmt.Sprint(v)
+		}
+	}
+	*l = list
+	return nil
+}
+
+// Market is a Gamma market
+// Dates are ISO 8601 strings as returned by the API.
+type Market struct {
+	ID                    ID         `json:"id"`
+	Question              string     `json:"question"`
+	ConditionID           string     `json:"conditionId"`
+	QuestionID            string     `json:"questionID"`
+	Slug                  string     `json:"slug"`
+	Description           string     `json:"description"`
+	ResolutionSource      string     `json:"resolutionSource"`
+	Category              string     `json:"category"`
+	Image                 string     `json:"image"`
+	Icon                  string     `json:"icon"`
+	StartDate             string     `json:"startDate"`
+	EndDate               string     `json:"endDate"`
+	CreatedAt             string     `json:"createdAt"`
+	UpdatedAt             string     `json:"updatedAt"`
+	ClosedTime            string     `json:"closedTime"`
+	Outcomes              StringList `json:"outcomes"`
+	OutcomePrices         StringList `json:"outcomePrices"`
+	ClobTokenIDs          StringList `json:"clobTokenIds"`
+	MarketMakerAddress    string     `json:"marketMakerAddress"`
+	GroupItemTitle        string     `json:"groupItemTitle"`
+	GroupItemThreshold    string     `json:"groupItemThreshold"`
+	Active                bool       `json:"active"`
+	Closed                bool       `json:"closed"`
+	Archived              bool       `json:"archived"`
+	New                   bool       `json:"new"`
+	Featured              bool       `json:"featured"`
+	Restricted            bool       `json:"restricted"`
+	EnableOrderBook       bool       `json:"enableOrderBook"`
+	AcceptingOrders       bool       `json:"acceptingOrders"`
+	NegRisk               bool       `json:"negRisk"`
+	NegRiskMarketID       string     `json:"negRiskMarketID"`
+	NegRiskRequestID      string     `json:"negRiskRequestID"`
+	Volume                Float      `json:"volume"`
+	VolumeNum             Float      `json:"volumeNum"`
+	Volume24hr            Float      `json:"volume24hr"`
+	Volume1wk             Float      `json:"volume1wk"`
+	Volume1mo             Float      `json:"volume1mo"`
+	Volume1yr             Float      `json:"volume1yr"`
+	Liquidity             Float      `json:"liquidity"`
+	LiquidityNum          Float      `json:"liquidityNum"`
+	OrderMinSize          Float      `json:"orderMinSize"`
+	OrderPriceMinTickSize Float      `json:"orderPriceMinTickSize"`
+	BestBid               Float      `json:"bestBid"`
+	BestAsk               Float      `json:"bestAsk"`
+	Spread                Float      `json:"spread"`
+	LastTradePrice        Float      `json:"lastTradePrice"`
+	OneDayPriceChange     Float      `json:"oneDayPriceChange"`
+	RewardsMinSize        Float      `json:"rewardsMinSize"`
+	RewardsMaxSpread      Float      `json:"rewardsMaxSpread"`
+	UMAResolutionStatus   string     `json:"umaResolutionStatus"`
+	GameID                ID         `json:"gameId"`
+	SportsMarketType      string     `json:"sportsMarketType"`
+	Events                []Event    `json:"events,omitempty"`
+	Tags                  []Tag      `json:"tags,omitempty"`
+}
+
+// Event is a Gamma event, a group of related markets
+type Event struct {
+	ID               ID       `json:"id"`
+	Ticker           string   `json:"ticker"`
+	Slug             string   `json:"slug"`
+	Title            string   `json:"title"`
+	Subtitle         string   `json:"subtitle"`
+	Description      string   `json:"description"`
+	ResolutionSource string   `json:"resolutionSource"`
+	Category         string   `json:"category"`
+	Image            string   `json:"image"`
+	Icon             string   `json:"icon"`
+	StartDate        string   `json:"startDate"`
+	EndDate          string   `json:"endDate"`
+	CreationDate     string   `json:"creationDate"`
+	CreatedAt        string   `json:"createdAt"`
+	UpdatedAt        string   `json:"updatedAt"`
+	Active           bool     `json:"active"`
+	Closed           bool     `json:"closed"`
+	Archived         bool     `json:"archived"`
+	New              bool     `json:"new"`
+	Featured         bool     `json:"featured"`
+	Restricted       bool     `json:"restricted"`
+	EnableOrderBook  bool     `json:"enableOrderBook"`
+	NegRisk          bool     `json:"negRisk"`
+	NegRiskMarketID  string   `json:"negRiskMarketID"`
+	EnableNegRisk    bool     `json:"enableNegRisk"`
+	NegRiskAugmented bool     `json:"negRiskAugmented"`
+	Volume           Float    `json:"volume"`
+	Volume24hr       Float    `json:"volume24hr"`
+	Volume1wk        Float    `json:"volume1wk"`
+	Volume1mo        Float    `json:"volume1mo"`
+	Volume1yr        Float    `json:"volume1yr"`
+	Liquidity        Float    `json:"liquidity"`
+	OpenInterest     Float    `json:"openInterest"`
+	CompetitiveScore Float    `json:"competitive"`
+	CommentCount     int      `json:"commentCount"`
+	SeriesSlug       string   `json:"seriesSlug"`
+	GameID           ID       `json:"gameId"`
+	Markets          []Market `json:"markets,omitempty"`
+	Series           []Series `json:"series,omitempty"`
+	Tags             []Tag    `json:"tags,omitempty"`
+}
+
+// Tag is a Gamma tag
+type Tag struct {
+	ID          ID     `json:"id"`
+	Label       string `json:"label"`
+	Slug        string `json:"slug"`
+	ForceShow   bool   `json:"forceShow"`
+	ForceHide   bool   `json:"forceHide"`
+	IsCarousel  bool   `json:"isCarousel"`
+	PublishedAt string `json:"publishedAt"`
+	CreatedAt   string `json:"createdAt"`
+	UpdatedAt   string `json:"updatedAt"`
+}
+
+// RelatedTag is a relationship between two tags
+type RelatedTag struct {
+	ID           ID  `json:"id"`
+	TagID        ID  `json:"tagID"`
+	RelatedTagID ID  `json:"relatedTagID"`
+	Rank         int `json:"rank"`
+}
+
+// Series is a Gamma series, a recurring group of events
+type Series struct {
+	ID           ID      `json:"id"`
+	Ticker       string  `json:"ticker"`
+	Slug         string  `json:"slug"`
+	Title        string  `json:"title"`
+	Subtitle     string  `json:"subtitle"`
+	SeriesType   string  `json:"seriesType"`
+	Recurrence   string  `json:"recurrence"`
+	Description  string  `json:"description"`
+	Image        string  `json:"image"`
+	Icon         string  `json:"icon"`
+	Layout       string  `json:"layout"`
+	Active       bool    `json:"active"`
+	Closed       bool    `json:"closed"`
+	Archived     bool    `json:"archived"`
+	New          bool    `json:"new"`
+	Featured     bool    `json:"featured"`
+	Restricted   bool    `json:"restricted"`
+	StartDate    string  `json:"startDate"`
+	CreatedAt    string  `json:"createdAt"`
+	UpdatedAt    string  `json:"updatedAt"`
+	Volume       Float   `json:"volume"`
+	Volume24hr   Float   `json:"volume24hr"`
+	Liquidity    Float   `json:"liquidity"`
+	CommentCount int     `json:"commentCount"`
+	Events       []Event `json:"events,omitempty"`
+	Tags         []Tag   `json:"tags,omitempty"`
+}
+
+// SportMetadata describes a sport and its default tags
+type SportMetadata struct {
+	Sport      string `json:"sport"`
+	Image      string `json:"image"`
+	Resolution string `json:"resolution"`
+	Ordering   string `json:"ordering"`
+	Tags       string `json:"tags"` // Comma separated tag IDs
+	Series     string `json:"series"`
+}
+
+// Team is a sports team
+type Team struct {
+	ID           ID     `json:"id"`
+	Name         string `json:"name"`
+	League       string `json:"league"`
+	Record       string `json:"record"`
+	Logo         string `json:"logo"`
+	Abbreviation string `json:"abbreviation"`
+	Alias        string `json:"alias"`
+	CreatedAt    string `json:"createdAt"`
+	UpdatedAt    string `json:"updatedAt"`
+}
+
+// Profile is the public profile of a user
+type Profile struct {
+	ID                    ID     `json:"id"`
+	Name                  string `json:"name"`
+	Pseudonym             string `json:"pseudonym"`
+	DisplayUsernamePublic bool   `json:"displayUsernamePublic"`
+	Bio                   string `json:"bio"`
+	ProxyWallet           string `json:"proxyWallet"`
+	BaseAddress           string `json:"baseAddress"`
+	ProfileImage          string `json:"profileImage"`
+}
+
+// CommentPosition is a position of a commenter in the commented market
+type CommentPosition struct {
+	TokenID      string `json:"tokenId"`
+	PositionSize Float  `json:"positionSize"`
+}
+
+// CommentProfile is the profile of a commenter
+type CommentProfile struct {
+	Profile
+	Positions []CommentPosition `json:"positions,omitempty"`
+}
+
+// Comment is a comment on an event, series or market
+type Comment struct {
+	ID               ID             `json:"id"`
+	Body             string         `json:"body"`
+	ParentEntityType string         `json:"parentEntityType"`
+	ParentEntityID   ID             `json:"parentEntityID"`
+	ParentCommentID  ID             `json:"parentCommentID"`
+	UserAddress      string         `json:"userAddress"`
+	ReplyAddress     string         `json:"replyAddress"`
+	ReactionCount    int            `json:"reactionCount"`
+	ReportCount      int            `json:"reportCount"`
+	CreatedAt        string         `json:"createdAt"`
+	UpdatedAt        string         `json:"updatedAt"`
+	Profile          CommentProfile `json:"profile"`
+}
+
+// Pagination is the pagination of the search results
+type Pagination struct {
+	HasMore      bool `json:"hasMore"`
+	TotalResults int  `json:"totalResults"`
+}
+
+// SearchResults are the results of a public search
+type SearchResults struct {
+	Events     []Event    `json:"events"`
+	Tags       []Tag      `json:"tags"`
+	Profiles   []Profile  `json:"profiles"`
+	Pagination Pagination `json:"pagination"`
+}
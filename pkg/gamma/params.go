@@ -0,0 +1,283 @@
+package gamma
+
+// ListParams are the pagination and sorting parameters of the list endpoints
+// The iterators page through the results from Offset, Limit items at a time, until an
+// empty page.
+type ListParams struct {
+	Limit     int
+	Offset    int
+	Order     string // Field to sort by, e.g. "volume24hr"
+	Ascending *bool
+}
+
+// MarketsParams filters the markets
+type MarketsParams struct {
+	ListParams
+
+	IDs                  []string
+	Slugs                []string
+	ClobTokenIDs         []string
+	ConditionIDs         []string
+	QuestionIDs          []string
+	MarketMakerAddresses []string
+
+	TagID       string
+	RelatedTags *bool // Also match the tags related to TagID
+	IncludeTag  *bool // Include the tags of each market
+
+	Active   *bool
+	Closed   *bool
+	Archived *bool
+
+	LiquidityNumMin float64
+	LiquidityNumMax float64
+	VolumeNumMin    float64
+	VolumeNumMax    float64
+	RewardsMinSize  float64
+
+	// ISO 8601 dates
+	StartDateMin string
+	StartDateMax string
+	EndDateMin   string
+	EndDateMax   string
+
+	UMAResolutionStatus string
+	GameID              string
+	SportsMarketTypes   []string
+}
+
+// values returns the query parameters
+func (p *MarketsParams) values() query {
+	q := query{}
+	if p == nil {
+		return q
+	}
+	q.list(p.ListParams)
+	q.strs("id", p.IDs)
+	q.strs("slug", p.Slugs)
+	q.strs("clob_token_ids", p.ClobTokenIDs)
+	q.strs("condition_ids", p.ConditionIDs)
+	q.strs("question_ids", p.QuestionIDs)
+	q.strs("market_maker_address", p.MarketMakerAddresses)
+	q.str("tag_id", p.TagID)
+	q.bool("related_tags", p.RelatedTags)
+	q.bool("include_tag", p.IncludeTag)
+	q.bool("active", p.Active)
+	q.bool("closed", p.Closed)
+	q.bool("archived", p.Archived)
+	q.float("liquidity_num_min", p.LiquidityNumMin)
+	q.float("liquidity_num_max", p.LiquidityNumMax)
+	q.float("volume_num_min", p.VolumeNumMin)
+	q.float("volume_num_max", p.VolumeNumMax)
+	q.float("rewards_min_size", p.RewardsMinSize)
+	q.str("start_date_min", p.StartDateMin)
+	q.str("start_date_max", p.StartDateMax)
+	q.str("end_date_min", p.EndDateMin)
+	q.str("end_date_max", p.EndDateMax)
+	q.str("uma_resolution_status", p.UMAResolutionStatus)
+	q.str("game_id", p.GameID)
+	q.strs("sports_market_types", p.SportsMarketTypes)
+	return q
+}
+
+// EventsParams filters the events
+type EventsParams struct {
+	ListParams
+
+	IDs           []string
+	Slugs         []string
+	TagID         string
+	TagSlug       string
+	ExcludeTagIDs []string
+	RelatedTags   *bool // Also match the tags related to TagID
+
+	Active          *bool
+	Closed          *bool
+	Archived        *bool
+	Featured        *bool
+	IncludeChat     *bool
+	IncludeTemplate *bool
+
+	LiquidityMin float64
+	LiquidityMax float64
+	VolumeMin    float64
+	VolumeMax    float64
+
+	// ISO 8601 dates
+	StartDateMin string
+	StartDateMax string
+	EndDateMin   string
+	EndDateMax   string
+
+	Recurrence string // e.g. "daily", "weekly"
+}
+
+// values returns the query parameters
+func (p *EventsParams) values() query {
+	q := query{}
+	if p == nil {
+		return q
+	}
+	q.list(p.ListParams)
+	q.strs("id", p.IDs)
+	q.strs("slug", p.Slugs)
+	q.str("tag_id", p.TagID)
+	q.str("tag_slug", p.TagSlug)
+	q.strs("exclude_tag_id", p.ExcludeTagIDs)
+	q.bool("related_tags", p.RelatedTags)
+	q.bool("active", p.Active)
+	q.bool("closed", p.Closed)
+	q.bool("archived", p.Archived)
+	q.bool("featured", p.Featured)
+	q.bool("include_chat", p.IncludeChat)
+	q.bool("include_template", p.IncludeTemplate)
+	q.float("liquidity_min", p.LiquidityMin)
+	q.float("liquidity_max", p.LiquidityMax)
+	q.float("volume_min", p.VolumeMin)
+	q.float("volume_max", p.VolumeMax)
+	q.str("start_date_min", p.StartDateMin)
+	q.str("start_date_max", p.StartDateMax)
+	q.str("end_date_min", p.EndDateMin)
+	q.str("end_date_max", p.EndDateMax)
+	q.str("recurrence", p.Recurrence)
+	return q
+}
+
+// TagsParams filters the tags
+type TagsParams struct {
+	ListParams
+
+	IncludeTemplate *bool
+	IsCarousel      *bool
+}
+
+// values returns the query parameters
+func (p *TagsParams) values() query {
+	q := query{}
+	if p == nil {
+		return q
+	}
+	q.list(p.ListParams)
+	q.bool("include_template", p.IncludeTemplate)
+	q.bool("is_carousel", p.IsCarousel)
+	return q
+}
+
+// SeriesParams filters the series
+type SeriesParams struct {
+	ListParams
+
+	Slugs          []string
+	CategoryIDs    []string
+	CategoryLabels []string
+	Closed         *bool
+	IncludeChat    *bool
+	Recurrence     string
+}
+
+// values returns the query parameters
+func (p *SeriesParams) values() query {
+	q := query{}
+	if p == nil {
+		return q
+	}
+	q.list(p.ListParams)
+	q.strs("slug", p.Slugs)
+	q.strs("categories_ids", p.CategoryIDs)
+	q.strs("categories_labels", p.CategoryLabels)
+	q.bool("closed", p.Closed)
+	q.bool("include_chat", p.IncludeChat)
+	q.str("recurrence", p.Recurrence)
+	return q
+}
+
+// TeamsParams filters the sports teams
+type TeamsParams struct {
+	ListParams
+
+	Leagues       []string
+	Names         []string
+	Abbreviations []string
+}
+
+// values returns the query parameters
+func (p *TeamsParams) values() query {
+	q := query{}
+	if p == nil {
+		return q
+	}
+	q.list(p.ListParams)
+	q.strs("league", p.Leagues)
+	q.strs("name", p.Names)
+	q.strs("abbreviation", p.Abbreviations)
+	return q
+}
+
+// Comment parent entity types
+const (
+	ParentEvent  = "Event"
+	ParentSeries = "Series"
+	ParentMarket = "market"
+)
+
+// CommentsParams filters the comments
+type CommentsParams struct {
+	ListParams
+
+	ParentEntityType string // ParentEvent, ParentSeries or ParentMarket
+	ParentEntityID   string
+	GetPositions     *bool // Include the positions of the commenters
+	HoldersOnly      *bool
+}
+
+// values returns the query parameters
+func (p *CommentsParams) values() query {
+	q := query{}
+	if p == nil {
+		return q
+	}
+	q.list(p.ListParams)
+	q.str("parent_entity_type", p.ParentEntityType)
+	q.str("parent_entity_id", p.ParentEntityID)
+	q.bool("get_positions", p.GetPositions)
+	q.bool("holders_only", p.HoldersOnly)
+	return q
+}
+
+// SearchParams are the parameters of a public search
+type SearchParams struct {
+	Query string
+
+	Page         int // From 1
+	LimitPerType int
+
+	EventsStatus      string // e.g. "active"
+	EventsTags        []string
+	ExcludeTagIDs     []string
+	KeepClosedMarkets *bool
+	Sort              string
+	Ascending         *bool
+	SearchTags        *bool
+	SearchProfiles    *bool
+	Recurrence        string
+	Cache             *bool
+}
+
+// values returns the query parameters
+func (p *SearchParams) values() query {
+	q := query{}
+	q.str("q", p.Query)
+	q.int("page", p.Page)
+	q.int("limit_per_type", p.LimitPerType)
+	q.str("events_status", p.EventsStatus)
+	q.strs("events_tag", p.EventsTags)
+	q.strs("exclude_tag_id", p.ExcludeTagIDs)
+	q.bool("keep_closed_markets", p.KeepClosedMarkets)
+	q.str("sort", p.Sort)
+	q.bool("ascending", p.Ascending)
+	q.bool("search_tags", p.SearchTags)
+	q.bool("search_profiles", p.SearchProfiles)
+	q.str("recurrence", p.Recurrence)
+	q.bool("cache", p.Cache)
+	return q
+}
@@ -0,0 +1,77 @@
+package gamma
+
+import (
+	"fmt"
+	"iter"
+	"net/url"
+)
+
+// GetTags returns a page of tags
+func (c *Client) GetTags(params *TagsParams) ([]Tag, error) {
+	var tags []Tag
+	if err := c.get("/tags", url.Values(params.values()), &tags); err != nil {
+		return nil, fmt.Errorf("failed to get tags: %w", err)
+	}
+	return tags, nil
+}
+
+// AllTags iterates over all the tags, page by page
+func (c *Client) AllTags(params TagsParams) iter.Seq2[Tag, error] {
+	return paginate(params.ListParams, func(page ListParams) ([]Tag, error) {
+		params.ListParams = page
+		return c.GetTags(&params)
+	})
+}
+
+// GetTag returns a tag by ID
+func (c *Client) GetTag(id string) (*Tag, error) {
+	var tag Tag
+	if err := c.get("/tags/"+url.PathEscape(id), nil, &tag); err != nil {
+		return nil, fmt.Errorf("failed to get tag %s: %w", id, err)
+	}
+	return &tag, nil
+}
+
+// GetTagBySlug returns a tag by slug
+func (c *Client) GetTagBySlug(slug string) (*Tag, error) {
+	var tag Tag
+	if err := c.get("/tags/slug/"+url.PathEscape(slug), nil, &tag); err != nil {
+		return nil, fmt.Errorf("failed to get tag %s: %w", slug, err)
+	}
+	return &tag, nil
+}
+
+// GetRelatedTags returns the relationships of a tag with other tags
+func (c *Client) GetRelatedTags(id string) ([]RelatedTag, error) {
+	var related []RelatedTag
+	if err := c.get("/tags/"+url.PathEscape(id)+"/related-tags", nil, &related); err != nil {
+		return nil, fmt.Errorf("failed to get related tags of %s: %w", id, err)
+	}
+	return related, nil
+}
+
+// GetSports returns the metadata of the sports
+func (c *Client) GetSports() ([]SportMetadata, error) {
+	var sports []SportMetadata
+	if err := c.get("/sports", nil, &sports); err != nil {
+		return nil, fmt.Errorf("failed to get sports: %w", err)
+	}
+	return sports, nil
+}
+
+// GetTeams returns a page of sports teams matching the params
+func (c *Client) GetTeams(params *TeamsParams) ([]Team, error) {
+	var teams []Team
+	if err := c.get("/teams", url.Values(params.values()), &teams); err != nil {
+		return nil, fmt.Errorf("failed to get teams: %w", err)
+	}
+	return teams, nil
+}
+
+// AllTeams iterates over all the sports teams matching the params, page by page
+func (c *Client) AllTeams(params TeamsParams) iter.Seq2[Team, error] {
+	return paginate(params.ListParams, func(page ListParams) ([]Team, error) {
+		params.ListParams = page
+		return c.GetTeams(&params)
+	})
+}
@@ -0,0 +1,145 @@
+package headers
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/pooofdevelopment/go-clob-client/pkg/httpclient"
+	"github.com/pooofdevelopment/go-clob-client/pkg/signing"
+	"github.com/pooofdevelopment/go-clob-client/pkg/types"
+)
+
+// Builder attribution header constants
+// Based on: builder-signing-sdk (BuilderHeaderPayload)
+const (
+	POLY_BUILDER_API_KEY    = "POLY_BUILDER_API_KEY"
+	POLY_BUILDER_PASSPHRASE = "POLY_BUILDER_PASSPHRASE"
+	POLY_BUILDER_SIGNATURE  = "POLY_BUILDER_SIGNATURE"
+	POLY_BUILDER_TIMESTAMP  = "POLY_BUILDER_TIMESTAMP"
+)
+
+// BuilderSigner produces the builder attribution headers for a request
+type BuilderSigner interface {
+	BuilderHeaders(requestArgs *types.RequestArgs, timestamp int64) (map[string]string, error)
+}
+
+// CreateBuilderHeaders creates the builder attribution headers for a request
+// The signature is an HMAC over the request, computed like the Level 2 signature but with
+// the builder credentials
+func CreateBuilderHeaders(creds *types.ApiCreds, requestArgs *types.RequestArgs, timestamp int64) (map[string]string, error) {
+	if creds == nil {
+		return nil, fmt.Errorf("builder credentials are required")
+	}
+
+	sig, err := signing.BuildHMACSignature(
+		creds.ApiSecret.Reveal(),
+		timestamp,
+		requestArgs.Method,
+		requestArgs.RequestPath,
+		requestArgs.Body,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build builder signature: %w", err)
+	}
+
+	return map[string]string{
+		POLY_BUILDER_API_KEY:    creds.ApiKey,
+		POLY_BUILDER_PASSPHRASE: creds.ApiPassphrase.Reveal(),
+		POLY_BUILDER_SIGNATURE:  sig,
+		POLY_BUILDER_TIMESTAMP:  fmt.Sprintf("%d", timestamp),
+	}, nil
+}
+
+// LocalBuilderSigner signs builder headers with builder credentials held in process
+type LocalBuilderSigner struct {
+	creds *types.ApiCreds
+}
+
+// NewLocalBuilderSigner creates a builder signer from builder API credentials
+func NewLocalBuilderSigner(creds *types.ApiCreds) *LocalBuilderSigner {
+	return &LocalBuilderSigner{creds: creds}
+}
+
+// BuilderHeaders implements BuilderSigner
+func (s *LocalBuilderSigner) BuilderHeaders(requestArgs *types.RequestArgs, timestamp int64) (map[string]string, error) {
+	return CreateBuilderHeaders(s.creds, requestArgs, timestamp)
+}
+
+// RemoteBuilderSigner requests builder headers from a remote signing service,
+// so builder credentials never have to be deployed next to the trading client
+// The service receives {"method", "path", "body", "timestamp"} and answers with the
+// POLY_BUILDER_* headers.
+type RemoteBuilderSigner struct {
+	url        string
+	token      types.Secret
+	httpClient *httpclient.Client
+}
+
+// NewRemoteBuilderSigner creates a builder signer calling the signing service at url
+// A non-empty token is sent as a bearer token
+func NewRemoteBuilderSigner(url string, token string) *RemoteBuilderSigner {
+	return &RemoteBuilderSigner{
+		url:        url,
+		token:      types.Secret(token),
+		httpClient: httpclient.NewClient(),
+	}
+}
+
+// remoteBuilderRequest is the payload sent to a remote builder signing service
+type remoteBuilderRequest struct {
+	Method    string `json:"method"`
+	Path      string `json:"path"`
+	Body      string `json:"body,omitempty"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+// BuilderHeaders implements BuilderSigner
+func (s *RemoteBuilderSigner) BuilderHeaders(requestArgs *types.RequestArgs, timestamp int64) (map[string]string, error) {
+	payload := remoteBuilderRequest{
+		Method:    requestArgs.Method,
+		Path:      requestArgs.RequestPath,
+		Timestamp: timestamp,
+	}
+	if requestArgs.Body != nil {
+		body, err := json.Marshal(requestArgs.Body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal body: %w", err)
+		}
+		payload.Body = string(body)
+	}
+
+	var h map[string]string
+	if s.token != "" {
+		h = map[string]string{"Authorization": "Bearer " + s.token.Reveal()}
+	}
+
+	response, err := s.httpClient.Post(s.url, h, payload)
+	if err != nil {
+		return nil, fmt.Errorf("remote builder signing failed: %w", err)
+	}
+
+	headers := make(map[string]string, 4)
+	for _, key := range []string{POLY_BUILDER_API_KEY, POLY_BUILDER_PASSPHRASE, POLY_BUILDER_SIGNATURE, POLY_BUILDER_TIMESTAMP} {
+		value, ok := response[key].(string)
+		if !ok || value == "" {
+			return nil, fmt.Errorf("remote builder signer response is missing %s", key)
+		}
+		headers[key] = value
+	}
+
+	return headers, nil
+}
+
+// AddBuilderHeaders merges the builder headers for a request into existing request headers
+func AddBuilderHeaders(h map[string]string, builder BuilderSigner, requestArgs *types.RequestArgs) error {
+	builderHeaders, err := builder.BuilderHeaders(requestArgs, time.Now().Unix())
+	if err != nil {
+		return err
+	}
+
+	for k, v := range builderHeaders {
+		h[k] = v
+	}
+	return nil
+}
@@ -63,7 +63,7 @@ func CreateLevel2Headers(signer *signer.Signer, creds *types.ApiCreds, requestAr
 	// Build HMAC signature
 	// Based on: py-clob-client-main/py_clob_client/headers/headers.py:42-48
 	hmacSig, err := signing.BuildHMACSignature(
-		creds.ApiSecret,
+		creds.ApiSecret.Reveal(),
 		timestamp,
 		requestArgs.Method,
 		requestArgs.RequestPath,
@@ -80,7 +80,7 @@ func CreateLevel2Headers(signer *signer.Signer, creds *types.ApiCreds, requestAr
 		POLY_SIGNATURE:  hmacSig,
 		POLY_TIMESTAMP:  fmt.Sprintf("%d", timestamp),
 		POLY_API_KEY:    creds.ApiKey,
-		POLY_PASSPHRASE: creds.ApiPassphrase,
+		POLY_PASSPHRASE: creds.ApiPassphrase.Reveal(),
 	}
 	
 	return headers, nil
@@ -5,18 +5,27 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 	
+	"github.com/pooofdevelopment/go-clob-client/pkg/errors"
+	"github.com/pooofdevelopment/go-clob-client/pkg/logging"
 	"github.com/pooofdevelopment/go-clob-client/pkg/types"
 )
 
 // Client wraps the standard HTTP client with common functionality
+// It is safe for concurrent use; the transport and rate limiter can be swapped at any time
+// and apply to requests sent afterwards.
 type Client struct {
+	mu         sync.RWMutex
 	httpClient *http.Client
+	limiter    *RateLimiter
+	logger     *slog.Logger
 }
 
 // NewClient creates a new HTTP client
@@ -25,6 +34,7 @@ func NewClient() *Client {
 		httpClient: &http.Client{
 			Timeout: 30 * time.Second,
 		},
+		logger: logging.Discard(),
 	}
 }
 
@@ -32,19 +42,78 @@ func NewClient() *Client {
 func NewClientWithHTTPClient(httpClient *http.Client) *Client {
 	return &Client{
 		httpClient: httpClient,
+		logger:     logging.Discard(),
 	}
 }
 
 // SetHTTPClient sets a custom HTTP client
 func (c *Client) SetHTTPClient(httpClient *http.Client) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	c.httpClient = httpClient
 }
 
+// SetRateLimiter sets a rate limiter applied to every request (nil disables rate limiting)
+func (c *Client) SetRateLimiter(limiter *RateLimiter) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.limiter = limiter
+}
+
+// SetLogger sets the structured logger of requests (nil disables logging)
+// Requests are logged at debug level with their endpoint, status and latency.
+func (c *Client) SetLogger(logger *slog.Logger) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.logger = logging.OrDiscard(logger)
+}
+
+// Clone returns a client sending through the same HTTP client, rate limiter and logger
+// Setters called on the clone afterwards do not affect c, and the other way around, while
+// the rate limiter itself stays shared.
+func (c *Client) Clone() *Client {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return &Client{
+		httpClient: c.httpClient,
+		limiter:    c.limiter,
+		logger:     c.logger,
+	}
+}
+
 // GetHTTPClient returns the underlying HTTP client
 func (c *Client) GetHTTPClient() *http.Client {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
 	return c.httpClient
 }
 
+// do sends a request with the current transport, waiting for the rate limiter first
+func (c *Client) do(req *http.Request) (*http.Response, error) {
+	c.mu.RLock()
+	httpClient, limiter, logger := c.httpClient, c.limiter, c.logger
+	c.mu.RUnlock()
+
+	limiter.Wait()
+	start := time.Now()
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		logger.Warn("HTTP request failed",
+			"method", req.Method,
+			logging.KeyEndpoint, req.URL.Path,
+			logging.KeyLatency, time.Since(start),
+			logging.KeyError, err)
+		return nil, err
+	}
+
+	logger.Debug("HTTP request",
+		"method", req.Method,
+		logging.KeyEndpoint, req.URL.Path,
+		"status", resp.StatusCode,
+		logging.KeyLatency, time.Since(start))
+	return resp, nil
+}
+
 // Get performs a GET request
 // Based on: py-clob-client-main/py_clob_client/http_helpers/helpers.py:50-60
 func (c *Client) Get(url string, headers map[string]string) (map[string]interface{}, error) {
@@ -58,7 +127,7 @@ func (c *Client) Get(url string, headers map[string]string) (map[string]interfac
 		req.Header.Set(k, v)
 	}
 	
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -92,7 +161,7 @@ func (c *Client) Post(url string, headers map[string]string, data interface{}) (
 		req.Header.Set(k, v)
 	}
 	
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -127,7 +196,7 @@ func (c *Client) Delete(url string, headers map[string]string, data interface{})
 		req.Header.Set(k, v)
 	}
 	
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -136,6 +205,80 @@ func (c *Client) Delete(url string, headers map[string]string, data interface{})
 	return c.parseResponse(resp)
 }
 
+// secretFields are response fields holding credentials, masked in error messages
+var secretFields = map[string]bool{
+	"secret":         true,
+	"passphrase":     true,
+	"apiSecret":      true,
+	"apiPassphrase":  true,
+	"api_secret":     true,
+	"api_passphrase": true,
+}
+
+// redactSecrets encodes an error response for error messages, masking credential fields
+func redactSecrets(data map[string]interface{}) string {
+	redacted := make(map[string]interface{}, len(data))
+	for k, v := range data {
+		if s, ok := v.(string); ok && secretFields[k] {
+			redacted[k] = types.Secret(s).String()
+			continue
+		}
+		redacted[k] = v
+	}
+
+	out, err := json.Marshal(redacted)
+	if err != nil {
+		return "<unprintable response>"
+	}
+	return string(out)
+}
+
+// GetJSON performs a GET request and decodes the JSON response into out
+func (c *Client) GetJSON(url string, headers map[string]string, out interface{}) error {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return err
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := c.do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return statusError(resp.StatusCode, body)
+	}
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("failed to parse response: %w", err)
+	}
+	return nil
+}
+
+// statusError builds the error of a non-2xx response, preferring its error message
+func statusError(status int, body []byte) error {
+	var errorData map[string]interface{}
+	if err := json.Unmarshal(body, &errorData); err != nil {
+		return &errors.HTTPError{StatusCode: status, Message: string(body)}
+	}
+
+	// Try to extract error message
+	if msg, ok := errorData["error"].(string); ok {
+		return &errors.HTTPError{StatusCode: status, Message: msg}
+	} else if msg, ok := errorData["message"].(string); ok {
+		return &errors.HTTPError{StatusCode: status, Message: msg}
+	}
+
+	return &errors.HTTPError{StatusCode: status, Message: redactSecrets(errorData)}
+}
+
 // parseResponse parses the HTTP response
 // Based on: py-clob-client-main/py_clob_client/http_helpers/helpers.py:35-47
 func (c *Client) parseResponse(resp *http.Response) (map[string]interface{}, error) {
@@ -146,19 +289,7 @@ func (c *Client) parseResponse(resp *http.Response) (map[string]interface{}, err
 	
 	// Check for non-2xx status codes
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		var errorData map[string]interface{}
-		if err := json.Unmarshal(body, &errorData); err != nil {
-			return nil, fmt.Errorf("HTTP %d: %s", resp.StatusCode, string(body))
-		}
-		
-		// Try to extract error message
-		if msg, ok := errorData["error"].(string); ok {
-			return nil, fmt.Errorf("HTTP %d: %s", resp.StatusCode, msg)
-		} else if msg, ok := errorData["message"].(string); ok {
-			return nil, fmt.Errorf("HTTP %d: %s", resp.StatusCode, msg)
-		}
-		
-		return nil, fmt.Errorf("HTTP %d: %s", resp.StatusCode, string(body))
+		return nil, statusError(resp.StatusCode, body)
 	}
 	
 	// First try to parse as JSON object
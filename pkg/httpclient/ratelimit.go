@@ -0,0 +1,58 @@
+package httpclient
+
+import (
+	"sync"
+	"time"
+)
+
+// RateLimiter is a token bucket limiting how fast requests are sent
+// A single RateLimiter can be shared between several Clients to enforce one combined budget
+type RateLimiter struct {
+	mu     sync.Mutex
+	rate   float64 // tokens added per second
+	burst  float64 // maximum number of tokens in the bucket
+	tokens float64
+	last   time.Time
+}
+
+// NewRateLimiter creates a rate limiter allowing requestsPerSecond on average with bursts of up to burst requests
+func NewRateLimiter(requestsPerSecond float64, burst int) *RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &RateLimiter{
+		rate:   requestsPerSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// Wait blocks until a request may be sent
+func (r *RateLimiter) Wait() {
+	if r == nil || r.rate <= 0 {
+		return
+	}
+
+	r.mu.Lock()
+	now := time.Now()
+
+	// Refill tokens for the time elapsed since the last call
+	r.tokens += now.Sub(r.last).Seconds() * r.rate
+	if r.tokens > r.burst {
+		r.tokens = r.burst
+	}
+	r.last = now
+
+	// Reserve a token; a negative balance is the time we owe the bucket
+	r.tokens--
+	var wait time.Duration
+	if r.tokens < 0 {
+		wait = time.Duration(-r.tokens / r.rate * float64(time.Second))
+	}
+	r.mu.Unlock()
+
+	if wait > 0 {
+		time.Sleep(wait)
+	}
+}
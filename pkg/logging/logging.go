@@ -0,0 +1,41 @@
+// Package logging provides the structured logger defaults of the library
+// Clients log through an injected *slog.Logger and are silent unless one is configured.
+package logging
+
+import (
+	"context"
+	"log/slog"
+)
+
+// Attribute keys shared by the packages of the library
+const (
+	KeyChannel  = "channel"
+	KeyAssetID  = "asset_id"
+	KeyEndpoint = "endpoint"
+	KeyLatency  = "latency"
+	KeyError    = "error"
+)
+
+// discardHandler drops all records
+type discardHandler struct{}
+
+func (discardHandler) Enabled(context.Context, slog.Level) bool  { return false }
+func (discardHandler) Handle(context.Context, slog.Record) error { return nil }
+func (h discardHandler) WithAttrs([]slog.Attr) slog.Handler      { return h }
+func (h discardHandler) WithGroup(string) slog.Handler           { return h }
+
+// discard is the shared silent logger
+var discard = slog.New(discardHandler{})
+
+// Discard returns a logger dropping all records, the default of every client
+func Discard() *slog.Logger {
+	return discard
+}
+
+// OrDiscard returns logger, or the silent logger when it is nil
+func OrDiscard(logger *slog.Logger) *slog.Logger {
+	if logger == nil {
+		return discard
+	}
+	return logger
+}
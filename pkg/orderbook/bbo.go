@@ -0,0 +1,287 @@
+package orderbook
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pooofdevelopment/go-clob-client/pkg/types"
+	"github.com/pooofdevelopment/go-clob-client/pkg/websocket"
+)
+
+// BBO is the top of book of a token: its best bid and offer
+type BBO struct {
+	TokenID string
+	Bid     Level // Zero when the bid side is empty
+	Ask     Level // Zero when the ask side is empty
+	HasBid  bool
+	HasAsk  bool
+
+	// Mid and Spread are only set when both sides are present
+	Mid    Decimal
+	Spread Decimal
+
+	Timestamp time.Time // Time of the book update that produced it
+}
+
+// sameTop reports whether two BBOs have the same prices and sizes
+func (b BBO) sameTop(other BBO) bool {
+	return b.Bid == other.Bid && b.Ask == other.Ask && b.HasBid == other.HasBid && b.HasAsk == other.HasAsk
+}
+
+// BBO returns the top of book
+func (b *OrderBook) BBO() BBO {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	bbo := BBO{TokenID: b.tokenID, Timestamp: msTime(b.timestamp)}
+	bbo.Bid, bbo.HasBid = b.bids.best()
+	bbo.Ask, bbo.HasAsk = b.asks.best()
+	if bbo.HasBid && bbo.HasAsk {
+		bbo.Mid = (bbo.Bid.Price + bbo.Ask.Price) / 2
+		bbo.Spread = bbo.Ask.Price - bbo.Bid.Price
+	}
+	return bbo
+}
+
+// BBOConfig configures a BBO stream
+type BBOConfig struct {
+	// Book is the template of the order books; TokenID is set per token, and TickSize
+	// defaults to 0.01 until a tick_size_change message arrives
+	Book Config
+
+	// Coalesce limits the updates to one per interval per token, carrying the latest top
+	// of book; 0 sends every change
+	Coalesce time.Duration
+
+	// Buffer is the size of the update channel, DefaultBBOBuffer when not set
+	Buffer int
+}
+
+// DefaultBBOBuffer is the update buffer size used when BBOConfig.Buffer is not set
+const DefaultBBOBuffer = 1024
+
+// BBOStream maintains an order book per token from websocket events and sends the top of
+// book whenever it changes
+// Updates are only sent for synced books, and only when the best bid or ask price or size
+// changed. A BBOStream is safe for concurrent use.
+type BBOStream struct {
+	config  BBOConfig
+	updates chan BBO
+
+	mu     sync.Mutex
+	books  map[string]*OrderBook
+	tops   map[string]*topState
+	closed bool
+
+	sendMu sync.RWMutex // Held for reading while sending, for writing while closing
+	done   chan struct{}
+	once   sync.Once
+}
+
+// topState is the coalescing state of one token
+// mu is held from reading the book to sending the update, so the updates of a token are
+// sent in order; the other fields are guarded by BBOStream.mu.
+type topState struct {
+	mu       sync.Mutex
+	sent     BBO
+	hasSent  bool
+	sentAt   time.Time
+	pending  *BBO        // Latest top waiting for the end of the interval
+	flushing *time.Timer // Set while an update is pending
+}
+
+// NewBBOStream creates a BBO stream
+func NewBBOStream(config BBOConfig) *BBOStream {
+	buffer := config.Buffer
+	if buffer <= 0 {
+		buffer = DefaultBBOBuffer
+	}
+	if config.Book.TickSize == "" {
+		config.Book.TickSize = types.TickSize("0.01")
+	}
+
+	return &BBOStream{
+		config:  config,
+		updates: make(chan BBO, buffer),
+		books:   make(map[string]*OrderBook),
+		tops:    make(map[string]*topState),
+		done:    make(chan struct{}),
+	}
+}
+
+// Updates returns the update channel, closed by Close
+func (s *BBOStream) Updates() <-chan BBO {
+	return s.updates
+}
+
+// Book returns the order book of a token, nil before its first event
+func (s *BBOStream) Book(tokenID string) *OrderBook {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.books[tokenID]
+}
+
+// Run handles events until the channel is closed, then closes the stream
+func (s *BBOStream) Run(events <-chan websocket.Event) {
+	defer s.Close()
+	for event := range events {
+		s.HandleEvent(event)
+	}
+}
+
+// HandleEvent applies a market event to the book of its token and sends its top of book
+// when it changed
+func (s *BBOStream) HandleEvent(event websocket.Event) {
+	var tokenID string
+	switch event.Type {
+	case websocket.EventBook:
+		tokenID = event.Book.AssetID
+	case websocket.EventPriceChange:
+		tokenID = event.PriceChange.AssetID
+	case websocket.EventTickSizeChange:
+		tokenID = event.TickSizeChange.AssetID
+	default:
+		return
+	}
+
+	book, err := s.book(tokenID)
+	if err != nil {
+		return
+	}
+	book.HandleEvent(event)
+	s.check(book)
+}
+
+// book returns the book of a token, creating it on its first event
+func (s *BBOStream) book(tokenID string) (*OrderBook, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if book, ok := s.books[tokenID]; ok {
+		return book, nil
+	}
+	if s.closed {
+		return nil, fmt.Errorf("BBO stream is closed")
+	}
+
+	config := s.config.Book
+	config.TokenID = tokenID
+	book, err := NewOrderBook(config)
+	if err != nil {
+		return nil, err
+	}
+	book.onResync = func() { s.check(book) }
+
+	s.books[tokenID] = book
+	s.tops[tokenID] = &topState{}
+	return book, nil
+}
+
+// check sends the top of book of a book when it changed, coalescing by interval
+func (s *BBOStream) check(book *OrderBook) {
+	if !book.Synced() {
+		return
+	}
+	top := s.top(book.tokenID)
+	if top == nil {
+		return
+	}
+	top.mu.Lock()
+	defer top.mu.Unlock()
+	bbo := book.BBO()
+
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return
+	}
+
+	if top.flushing != nil {
+		// Sent at the end of the interval
+		top.pending = &bbo
+		s.mu.Unlock()
+		return
+	}
+	if top.hasSent && top.sent.sameTop(bbo) {
+		s.mu.Unlock()
+		return
+	}
+
+	now := time.Now()
+	if wait := s.config.Coalesce - now.Sub(top.sentAt); top.hasSent && wait > 0 {
+		top.pending = &bbo
+		top.flushing = time.AfterFunc(wait, func() { s.flush(bbo.TokenID) })
+		s.mu.Unlock()
+		return
+	}
+
+	top.sent, top.hasSent, top.sentAt = bbo, true, now
+	s.mu.Unlock()
+	s.send(bbo)
+}
+
+// flush sends the pending top of book of a token at the end of its interval
+func (s *BBOStream) flush(tokenID string) {
+	top := s.top(tokenID)
+	if top == nil {
+		return
+	}
+	top.mu.Lock()
+	defer top.mu.Unlock()
+
+	s.mu.Lock()
+	if s.closed || top.pending == nil {
+		s.mu.Unlock()
+		return
+	}
+
+	bbo := *top.pending
+	top.pending = nil
+	top.flushing = nil
+	if top.sent.sameTop(bbo) {
+		// Changed back within the interval
+		s.mu.Unlock()
+		return
+	}
+	top.sent, top.sentAt = bbo, time.Now()
+	s.mu.Unlock()
+	s.send(bbo)
+}
+
+// top returns the coalescing state of a token
+func (s *BBOStream) top(tokenID string) *topState {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.tops[tokenID]
+}
+
+// send delivers an update, blocking while the buffer is full
+func (s *BBOStream) send(bbo BBO) {
+	s.sendMu.RLock()
+	defer s.sendMu.RUnlock()
+
+	select {
+	case s.updates <- bbo:
+	case <-s.done:
+	}
+}
+
+// Close stops the stream and closes the update channel
+func (s *BBOStream) Close() {
+	s.once.Do(func() {
+		s.mu.Lock()
+		s.closed = true
+		for _, top := range s.tops {
+			if top.flushing != nil {
+				top.flushing.Stop()
+			}
+		}
+		s.mu.Unlock()
+
+		close(s.done)
+		s.sendMu.Lock()
+		defer s.sendMu.Unlock()
+		close(s.updates)
+	})
+}
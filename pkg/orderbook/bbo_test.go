@@ -0,0 +1,167 @@
+package orderbook_test
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pooofdevelopment/go-clob-client/pkg/orderbook"
+	"github.com/pooofdevelopment/go-clob-client/pkg/websocket"
+)
+
+// receiveBBO waits for the next top of book update
+func receiveBBO(t *testing.T, stream *orderbook.BBOStream) orderbook.BBO {
+	t.Helper()
+	select {
+	case bbo := <-stream.Updates():
+		return bbo
+	case <-time.After(2 * time.Second):
+		t.Fatal("no BBO update received")
+		return orderbook.BBO{}
+	}
+}
+
+// expectNoBBO checks that no top of book update is sent within wait
+func expectNoBBO(t *testing.T, stream *orderbook.BBOStream, wait time.Duration) {
+	t.Helper()
+	select {
+	case bbo := <-stream.Updates():
+		t.Errorf("unexpected BBO update %+v", bbo)
+	case <-time.After(wait):
+	}
+}
+
+func priceChangeEvent(timestamp string, changes ...websocket.PriceChange) websocket.Event {
+	return websocket.Event{Type: websocket.EventPriceChange, PriceChange: priceChange(timestamp, changes...)}
+}
+
+// TestBBOStream tests that updates are only sent when the top of book changes
+func TestBBOStream(t *testing.T) {
+	stream := orderbook.NewBBOStream(orderbook.BBOConfig{})
+	defer stream.Close()
+
+	stream.HandleEvent(websocket.Event{Type: websocket.EventBook, Book: bookSnapshot("100")})
+	bbo := receiveBBO(t, stream)
+	if bbo.TokenID != "1234" || !bbo.HasBid || !bbo.HasAsk {
+		t.Fatalf("BBO = %+v", bbo)
+	}
+	if bbo.Bid.Price.String() != "0.5" || bbo.Bid.Size.String() != "10" || bbo.Ask.Price.String() != "0.52" {
+		t.Errorf("BBO = %s x %s, want 0.5 x 0.52", bbo.Bid.Price, bbo.Ask.Price)
+	}
+	if bbo.Mid.String() != "0.51" || bbo.Spread.String() != "0.02" {
+		t.Errorf("mid = %s, spread = %s, want 0.51 and 0.02", bbo.Mid, bbo.Spread)
+	}
+	if !bbo.Timestamp.Equal(time.UnixMilli(100)) {
+		t.Errorf("Timestamp = %v, want 100ms", bbo.Timestamp)
+	}
+
+	// Below the top of book
+	stream.HandleEvent(priceChangeEvent("101", websocket.PriceChange{Price: "0.48", Side: "BUY", Size: "5"}))
+	expectNoBBO(t, stream, 50*time.Millisecond)
+
+	// Size change at the top
+	stream.HandleEvent(priceChangeEvent("102", websocket.PriceChange{Price: "0.52", Side: "SELL", Size: "7"}))
+	if bbo := receiveBBO(t, stream); bbo.Ask.Size.String() != "7" || bbo.Bid.Price.String() != "0.5" {
+		t.Errorf("BBO after the ask size change = %+v", bbo)
+	}
+
+	// Emptied ask side
+	stream.HandleEvent(priceChangeEvent("103",
+		websocket.PriceChange{Price: "0.52", Side: "SELL", Size: "0"},
+		websocket.PriceChange{Price: "0.55", Side: "SELL", Size: "0"},
+	))
+	if bbo := receiveBBO(t, stream); bbo.HasAsk || bbo.Mid != 0 || bbo.Spread != 0 {
+		t.Errorf("BBO without asks = %+v", bbo)
+	}
+
+	if stream.Book("1234") == nil || stream.Book("other") != nil {
+		t.Error("Book() should return the books of the received tokens")
+	}
+
+	stream.Close()
+	if _, ok := <-stream.Updates(); ok {
+		t.Error("Updates() should be closed")
+	}
+}
+
+// TestBBOStreamCoalesce tests that coalescing sends at most one update per interval with the latest top
+func TestBBOStreamCoalesce(t *testing.T) {
+	stream := orderbook.NewBBOStream(orderbook.BBOConfig{Coalesce: 200 * time.Millisecond})
+	defer stream.Close()
+
+	stream.HandleEvent(websocket.Event{Type: websocket.EventBook, Book: bookSnapshot("100")})
+	first := receiveBBO(t, stream)
+	sentAt := time.Now()
+
+	for i, size := range []string{"11", "12", "13"} {
+		stream.HandleEvent(priceChangeEvent(strconv.Itoa(200+i), websocket.PriceChange{Price: "0.5", Side: "BUY", Size: size}))
+	}
+	expectNoBBO(t, stream, 100*time.Millisecond)
+
+	bbo := receiveBBO(t, stream)
+	if elapsed := time.Since(sentAt); elapsed < 150*time.Millisecond {
+		t.Errorf("coalesced update after %s, want about 200ms", elapsed)
+	}
+	if bbo.Bid.Size.String() != "13" || first.Bid.Size.String() != "10" {
+		t.Errorf("coalesced bid size = %s, want the latest 13", bbo.Bid.Size)
+	}
+	expectNoBBO(t, stream, 250*time.Millisecond)
+
+	// A top restored within the interval sends nothing
+	stream.HandleEvent(priceChangeEvent("500", websocket.PriceChange{Price: "0.5", Side: "BUY", Size: "14"}))
+	if bbo := receiveBBO(t, stream); bbo.Bid.Size.String() != "14" {
+		t.Errorf("bid size = %s, want 14", bbo.Bid.Size)
+	}
+	stream.HandleEvent(priceChangeEvent("501", websocket.PriceChange{Price: "0.5", Side: "BUY", Size: "15"}))
+	stream.HandleEvent(priceChangeEvent("502", websocket.PriceChange{Price: "0.5", Side: "BUY", Size: "14"}))
+	expectNoBBO(t, stream, 300*time.Millisecond)
+}
+
+// TestBBOStreamConcurrentUpdates tests that the last update of a token matches its book when
+// events are handled concurrently
+func TestBBOStreamConcurrentUpdates(t *testing.T) {
+	stream := orderbook.NewBBOStream(orderbook.BBOConfig{})
+	defer stream.Close()
+
+	stream.HandleEvent(websocket.Event{Type: websocket.EventBook, Book: bookSnapshot("100")})
+	receiveBBO(t, stream)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 1; j <= 50; j++ {
+				size := strconv.Itoa(i*100 + j)
+				stream.HandleEvent(priceChangeEvent("200", websocket.PriceChange{Price: "0.5", Side: "BUY", Size: size}))
+			}
+		}(i)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	var last orderbook.BBO
+	for running := true; running; {
+		select {
+		case last = <-stream.Updates():
+		case <-done:
+			running = false
+		}
+	}
+	// Sent before the handlers returned
+	for drained := false; !drained; {
+		select {
+		case last = <-stream.Updates():
+		default:
+			drained = true
+		}
+	}
+
+	if want := stream.Book("1234").BBO(); last.Bid != want.Bid {
+		t.Errorf("last update bid = %+v, want the book's %+v", last.Bid, want.Bid)
+	}
+}
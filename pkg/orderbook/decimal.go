@@ -0,0 +1,86 @@
+package orderbook
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// decimalPlaces is the precision of Decimal, the precision of USDC and conditional tokens
+const decimalPlaces = 6
+
+// decimalScale is 10^decimalPlaces
+const decimalScale = 1_000_000
+
+// Decimal is an exact fixed-point number with six decimal places
+// Prices and sizes of the order book are kept as Decimal so repeated updates do not
+// accumulate floating point errors.
+type Decimal int64
+
+// ParseDecimal parses a decimal string such as "0.52" or "1500.25"
+func ParseDecimal(s string) (Decimal, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, fmt.Errorf("invalid decimal: empty string")
+	}
+
+	negative := strings.HasPrefix(s, "-")
+	digits := strings.TrimPrefix(s, "-")
+
+	whole, frac, _ := strings.Cut(digits, ".")
+	if whole == "" {
+		whole = "0"
+	}
+	frac = strings.TrimRight(frac, "0")
+	if len(frac) > decimalPlaces {
+		return 0, fmt.Errorf("invalid decimal %q: more than %d decimal places", s, decimalPlaces)
+	}
+	frac += strings.Repeat("0", decimalPlaces-len(frac))
+
+	w, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil || w < 0 {
+		return 0, fmt.Errorf("invalid decimal %q", s)
+	}
+	f, err := strconv.ParseInt(frac, 10, 64)
+	if err != nil || f < 0 {
+		return 0, fmt.Errorf("invalid decimal %q", s)
+	}
+
+	d := Decimal(w*decimalScale + f)
+	if negative {
+		d = -d
+	}
+	return d, nil
+}
+
+// MustParseDecimal parses a decimal string and panics when it is invalid
+func MustParseDecimal(s string) Decimal {
+	d, err := ParseDecimal(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// String formats the decimal without trailing zeros
+func (d Decimal) String() string {
+	sign := ""
+	if d < 0 {
+		sign = "-"
+		d = -d
+	}
+
+	whole := int64(d) / decimalScale
+	frac := int64(d) % decimalScale
+	if frac == 0 {
+		return fmt.Sprintf("%s%d", sign, whole)
+	}
+
+	fracStr := strings.TrimRight(fmt.Sprintf("%06d", frac), "0")
+	return fmt.Sprintf("%s%d.%s", sign, whole, fracStr)
+}
+
+// Float64 returns the decimal as a float
+func (d Decimal) Float64() float64 {
+	return float64(d) / decimalScale
+}
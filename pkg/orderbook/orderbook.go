@@ -0,0 +1,641 @@
+package orderbook
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pooofdevelopment/go-clob-client/pkg/logging"
+	"github.com/pooofdevelopment/go-clob-client/pkg/types"
+	"github.com/pooofdevelopment/go-clob-client/pkg/websocket"
+)
+
+// resyncBackoff is the minimum time between two resync attempts
+const resyncBackoff = time.Second
+
+// Level is an aggregated price level
+type Level struct {
+	Price Decimal
+	Size  Decimal
+}
+
+// Source provides order book snapshots for resyncs; *client.ClobClient implements it
+type Source interface {
+	GetOrderBook(tokenID string) (*types.OrderBookSummary, error)
+}
+
+// HashFunc computes the hash of a book, to compare with the hash sent with each update
+type HashFunc func(summary *types.OrderBookSummary) string
+
+// Config configures an OrderBook
+type Config struct {
+	TokenID  string
+	TickSize types.TickSize
+
+	// Source is used to resync the book on its own; nil only marks the book as out of sync
+	Source Source
+
+	// StaleAfter marks the book stale, and resyncs it from Watch, when no update arrived
+	// for that long; 0 disables staleness detection
+	StaleAfter time.Duration
+
+	// VerifyHash checks the book against the hash of each update where the hash scheme is
+	// known; nil skips the check
+	VerifyHash HashFunc
+
+	// Logger receives resyncs and invalid updates; nil is silent
+	Logger *slog.Logger
+
+	// MaxPending bounds the deltas buffered while the book is out of sync; beyond it the
+	// oldest are dropped. 0 uses DefaultMaxPending
+	MaxPending int
+}
+
+// DefaultMaxPending is the default bound of the deltas buffered while a book is out of sync
+const DefaultMaxPending = 10000
+
+// OrderBook is an order book for one token kept up to date from websocket snapshots
+// (book messages) and deltas (price_change messages)
+// The book resyncs from its Source when it detects a gap: a delta without a snapshot, a
+// crossed book, a hash mismatch or staleness. Deltas arriving during a resync are buffered
+// and replayed on top of the new snapshot. An OrderBook is safe for concurrent use.
+type OrderBook struct {
+	tokenID    string
+	source     Source
+	staleAfter time.Duration
+	verifyHash HashFunc
+	logger     *slog.Logger
+	onResync   func() // Called after a successful resync, see BBOStream
+	maxPending int
+
+	mu        sync.RWMutex
+	market    string
+	tickSize  Decimal
+	bids      *bookSide
+	asks      *bookSide
+	timestamp int64 // Milliseconds, of the last applied update
+	hash      string
+	synced    bool
+	updatedAt time.Time
+
+	resyncing     bool
+	resyncDone    chan struct{} // Closed when the resync in flight completes
+	lastResync    time.Time
+	pending       []*websocket.PriceChangeUpdate // Deltas received while out of sync
+	resyncs       int
+	lastResyncErr error
+}
+
+// NewOrderBook creates an empty order book
+func NewOrderBook(config Config) (*OrderBook, error) {
+	if config.TokenID == "" {
+		return nil, fmt.Errorf("token ID is required")
+	}
+	tickSize, err := ParseDecimal(string(config.TickSize))
+	if err != nil || tickSize <= 0 {
+		return nil, fmt.Errorf("invalid tick size: %s", config.TickSize)
+	}
+
+	maxPending := config.MaxPending
+	if maxPending <= 0 {
+		maxPending = DefaultMaxPending
+	}
+
+	return &OrderBook{
+		tokenID:    config.TokenID,
+		maxPending: maxPending,
+		source:     config.Source,
+		staleAfter: config.StaleAfter,
+		verifyHash: config.VerifyHash,
+		logger:     logging.OrDiscard(config.Logger).With(logging.KeyAssetID, config.TokenID),
+		tickSize:   tickSize,
+		bids:       newBookSide(true),
+		asks:       newBookSide(false),
+	}, nil
+}
+
+// TokenID returns the token of the book
+func (b *OrderBook) TokenID() string {
+	return b.tokenID
+}
+
+// HandleEvent applies the websocket events of the book's token and ignores all others
+func (b *OrderBook) HandleEvent(event websocket.Event) {
+	var err error
+	switch event.Type {
+	case websocket.EventBook:
+		if event.Book.AssetID == b.tokenID {
+			err = b.ApplySnapshot(event.Book)
+		}
+	case websocket.EventPriceChange:
+		if event.PriceChange.AssetID == b.tokenID {
+			err = b.ApplyPriceChange(event.PriceChange)
+		}
+	case websocket.EventTickSizeChange:
+		if event.TickSizeChange.AssetID == b.tokenID {
+			err = b.ApplyTickSizeChange(event.TickSizeChange)
+		}
+	}
+	if err != nil {
+		b.logger.Warn("Failed to apply order book event", "event_type", event.Type, logging.KeyError, err)
+	}
+}
+
+// ApplySnapshot replaces the book with a websocket book message
+func (b *OrderBook) ApplySnapshot(update *websocket.OrderBookUpdate) error {
+	if update.AssetID != b.tokenID {
+		return fmt.Errorf("snapshot for token %s applied to book %s", update.AssetID, b.tokenID)
+	}
+
+	bids, asks := update.Buys, update.Sells
+	if len(bids) == 0 {
+		bids = update.Bids
+	}
+	if len(asks) == 0 {
+		asks = update.Asks
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.applySnapshot(update.Market, update.Timestamp, update.Hash, bids, asks)
+}
+
+// ApplySummary replaces the book with an order book summary, e.g. from GetOrderBook
+func (b *OrderBook) ApplySummary(summary *types.OrderBookSummary) error {
+	if summary.AssetID != "" && summary.AssetID != b.tokenID {
+		return fmt.Errorf("summary for token %s applied to book %s", summary.AssetID, b.tokenID)
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.applySnapshot(summary.Market, summary.Timestamp, summary.Hash, summary.Bids, summary.Asks)
+}
+
+// applySnapshot replaces the levels and replays buffered deltas
+// Snapshots older than the book are ignored.
+func (b *OrderBook) applySnapshot(market, timestamp, hash string, bids, asks []types.OrderSummary) error {
+	ts := parseTimestamp(timestamp)
+	if b.synced && ts != 0 && ts < b.timestamp {
+		return nil
+	}
+
+	newBids, newAsks := newBookSide(true), newBookSide(false)
+	for _, level := range bids {
+		if err := newBids.setString(level.Price, level.Size); err != nil {
+			return fmt.Errorf("invalid bid level: %w", err)
+		}
+	}
+	for _, level := range asks {
+		if err := newAsks.setString(level.Price, level.Size); err != nil {
+			return fmt.Errorf("invalid ask level: %w", err)
+		}
+	}
+
+	if market != "" {
+		b.market = market
+	}
+	b.bids, b.asks = newBids, newAsks
+	b.timestamp = ts
+	b.hash = hash
+	b.synced = true
+	b.updatedAt = time.Now()
+
+	// Replay the deltas received while the snapshot was fetched
+	pending := b.pending
+	b.pending = nil
+	for _, update := range pending {
+		if ts == 0 || parseTimestamp(update.Timestamp) > ts {
+			if err := b.applyPriceChange(update); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// ApplyPriceChange applies a websocket price_change message
+// A delta for an unsynced book, or one that leaves the book inconsistent, triggers a resync.
+func (b *OrderBook) ApplyPriceChange(update *websocket.PriceChangeUpdate) error {
+	if update.AssetID != b.tokenID {
+		return fmt.Errorf("price change for token %s applied to book %s", update.AssetID, b.tokenID)
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.resyncing {
+		b.bufferDelta(update)
+		return nil
+	}
+	if !b.synced {
+		b.bufferDelta(update)
+		b.triggerResync("price change before snapshot")
+		return nil
+	}
+
+	return b.applyPriceChange(update)
+}
+
+// bufferDelta keeps a delta to replay on the next snapshot, dropping the oldest beyond
+// maxPending. Must be called with the lock held.
+func (b *OrderBook) bufferDelta(update *websocket.PriceChangeUpdate) {
+	if len(b.pending) >= b.maxPending {
+		dropped := len(b.pending) - b.maxPending + 1
+		b.pending = append(b.pending[:0], b.pending[dropped:]...)
+	}
+	b.pending = append(b.pending, update)
+}
+
+// applyPriceChange applies a delta to a synced book
+func (b *OrderBook) applyPriceChange(update *websocket.PriceChangeUpdate) error {
+	ts := parseTimestamp(update.Timestamp)
+	if ts != 0 && ts < b.timestamp {
+		// Older than the book, already included
+		return nil
+	}
+
+	for _, change := range update.Changes {
+		var err error
+		switch change.Side {
+		case types.BUY:
+			err = b.bids.setString(change.Price, change.Size)
+		case types.SELL:
+			err = b.asks.setString(change.Price, change.Size)
+		default:
+			err = fmt.Errorf("invalid side %q", change.Side)
+		}
+		if err != nil {
+			b.triggerResync(fmt.Sprintf("invalid price change: %v", err))
+			return err
+		}
+	}
+
+	if ts != 0 {
+		b.timestamp = ts
+	}
+	b.hash = update.Hash
+	b.updatedAt = time.Now()
+
+	// Check the book is consistent
+	if bid, ok := b.bids.best(); ok {
+		if ask, ok := b.asks.best(); ok && bid.Price >= ask.Price {
+			b.triggerResync(fmt.Sprintf("crossed book: bid %s >= ask %s", bid.Price, ask.Price))
+			return nil
+		}
+	}
+	if b.verifyHash != nil && update.Hash != "" {
+		if hash := b.verifyHash(b.summary()); hash != update.Hash {
+			b.triggerResync(fmt.Sprintf("hash mismatch: %s != %s", hash, update.Hash))
+		}
+	}
+
+	return nil
+}
+
+// ApplyTickSizeChange updates the tick size of the book
+func (b *OrderBook) ApplyTickSizeChange(update *websocket.TickSizeChangeUpdate) error {
+	tickSize, err := ParseDecimal(update.NewTickSize)
+	if err != nil || tickSize <= 0 {
+		return fmt.Errorf("invalid tick size: %s", update.NewTickSize)
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.tickSize = tickSize
+	return nil
+}
+
+// triggerResync marks the book out of sync and starts a resync from the source
+// Must be called with the lock held.
+func (b *OrderBook) triggerResync(reason string) {
+	b.synced = false
+	if b.source == nil || b.resyncing || time.Since(b.lastResync) < resyncBackoff {
+		return
+	}
+
+	b.logger.Info("Order book out of sync, resyncing", "reason", reason)
+	b.startResync()
+	go func() {
+		if b.resync() == nil && b.onResync != nil {
+			b.onResync()
+		}
+	}()
+}
+
+// Resync replaces the book with a snapshot from the source
+// When a resync is already in flight, it waits for that one and returns its result.
+func (b *OrderBook) Resync() error {
+	if b.source == nil {
+		return fmt.Errorf("order book %s has no snapshot source", b.tokenID)
+	}
+
+	b.mu.Lock()
+	if b.resyncing {
+		done := b.resyncDone
+		b.mu.Unlock()
+		<-done
+
+		b.mu.RLock()
+		defer b.mu.RUnlock()
+		return b.lastResyncErr
+	}
+	b.startResync()
+	b.mu.Unlock()
+
+	if err := b.resync(); err != nil {
+		return err
+	}
+	if b.onResync != nil {
+		b.onResync()
+	}
+	return nil
+}
+
+// startResync marks a resync in flight; only one runs at a time
+// Must be called with the lock held.
+func (b *OrderBook) startResync() {
+	b.resyncing = true
+	b.resyncDone = make(chan struct{})
+	b.lastResync = time.Now()
+}
+
+// resync fetches and applies a snapshot while deltas are buffered
+func (b *OrderBook) resync() error {
+	summary, err := b.source.GetOrderBook(b.tokenID)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.resyncing = false
+	defer close(b.resyncDone)
+	b.resyncs++
+	if err == nil {
+		// The fetched snapshot replaces the book even when older than the last delta
+		b.synced = false
+		err = b.applySnapshot(summary.Market, summary.Timestamp, summary.Hash, summary.Bids, summary.Asks)
+	}
+	if err != nil {
+		b.synced = false
+		b.pending = nil
+		b.lastResyncErr = fmt.Errorf("resync failed: %w", err)
+		b.logger.Warn("Order book resync failed", logging.KeyError, err)
+		return b.lastResyncErr
+	}
+
+	b.lastResyncErr = nil
+	return nil
+}
+
+// Watch resyncs the book whenever it is stale or out of sync, until ctx is done
+func (b *OrderBook) Watch(ctx context.Context) {
+	interval := b.staleAfter
+	if interval <= 0 || interval > resyncBackoff {
+		interval = resyncBackoff
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			b.mu.Lock()
+			if stale := b.isStale(); stale && !b.resyncing {
+				b.triggerResync("stale")
+			}
+			b.mu.Unlock()
+		}
+	}
+}
+
+// Stale reports whether the book is out of sync or received no update for StaleAfter
+func (b *OrderBook) Stale() bool {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.isStale()
+}
+
+// isStale reports staleness with the lock held
+func (b *OrderBook) isStale() bool {
+	return !b.synced || (b.staleAfter > 0 && time.Since(b.updatedAt) > b.staleAfter)
+}
+
+// Synced reports whether the book holds a consistent snapshot with its deltas applied
+func (b *OrderBook) Synced() bool {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.synced
+}
+
+// Resyncs returns the number of resyncs from the source and the error of the last one
+func (b *OrderBook) Resyncs() (int, error) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.resyncs, b.lastResyncErr
+}
+
+// Hash returns the hash of the last applied update
+func (b *OrderBook) Hash() string {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.hash
+}
+
+// Timestamp returns the time of the last applied update, the zero time when unknown
+func (b *OrderBook) Timestamp() time.Time {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return msTime(b.timestamp)
+}
+
+// BestBid returns the highest bid
+func (b *OrderBook) BestBid() (Level, bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.bids.best()
+}
+
+// BestAsk returns the lowest ask
+func (b *OrderBook) BestAsk() (Level, bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.asks.best()
+}
+
+// Spread returns the difference between the best ask and the best bid
+func (b *OrderBook) Spread() (Decimal, bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	bid, okBid := b.bids.best()
+	ask, okAsk := b.asks.best()
+	if !okBid || !okAsk {
+		return 0, false
+	}
+	return ask.Price - bid.Price, true
+}
+
+// Depth returns the best n levels of each side, best first; n <= 0 returns all levels
+func (b *OrderBook) Depth(n int) (bids []Level, asks []Level) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.bids.levels(n), b.asks.levels(n)
+}
+
+// LevelsWithinTicks returns the levels at most n ticks away from the best price of each side
+func (b *OrderBook) LevelsWithinTicks(n int) (bids []Level, asks []Level) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	offset := b.tickSize * Decimal(n)
+	if bid, ok := b.bids.best(); ok {
+		bids = b.bids.within(bid.Price - offset)
+	}
+	if ask, ok := b.asks.best(); ok {
+		asks = b.asks.within(ask.Price + offset)
+	}
+	return bids, asks
+}
+
+// Summary returns the book as an order book summary, bids descending and asks ascending
+func (b *OrderBook) Summary() *types.OrderBookSummary {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.summary()
+}
+
+// summary builds the summary with the lock held
+func (b *OrderBook) summary() *types.OrderBookSummary {
+	return &types.OrderBookSummary{
+		Market:    b.market,
+		AssetID:   b.tokenID,
+		Timestamp: strconv.FormatInt(b.timestamp, 10),
+		Bids:      b.bids.summaries(),
+		Asks:      b.asks.summaries(),
+		Hash:      b.hash,
+	}
+}
+
+// msTime converts a millisecond timestamp, the zero time for 0
+func msTime(ms int64) time.Time {
+	if ms == 0 {
+		return time.Time{}
+	}
+	return time.UnixMilli(ms)
+}
+
+// parseTimestamp parses a millisecond timestamp, 0 when missing or invalid
+func parseTimestamp(timestamp string) int64 {
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return ts
+}
+
+// bookSide holds the levels of one side sorted best first
+type bookSide struct {
+	descending bool // Bids are sorted by descending price
+	sizes      map[Decimal]Decimal
+	prices     []Decimal
+}
+
+// newBookSide creates an empty side
+func newBookSide(descending bool) *bookSide {
+	return &bookSide{descending: descending, sizes: make(map[Decimal]Decimal)}
+}
+
+// setString sets the size of a level from strings, removing it for a zero size
+func (s *bookSide) setString(price, size string) error {
+	p, err := ParseDecimal(price)
+	if err != nil {
+		return err
+	}
+	q, err := ParseDecimal(size)
+	if err != nil {
+		return err
+	}
+	if p <= 0 || q < 0 {
+		return fmt.Errorf("invalid level %s @ %s", size, price)
+	}
+
+	s.set(p, q)
+	return nil
+}
+
+// set sets the size of a level, removing it for a zero size
+func (s *bookSide) set(price, size Decimal) {
+	_, exists := s.sizes[price]
+	i := s.search(price)
+
+	if size == 0 {
+		if exists {
+			delete(s.sizes, price)
+			s.prices = append(s.prices[:i], s.prices[i+1:]...)
+		}
+		return
+	}
+
+	s.sizes[price] = size
+	if !exists {
+		s.prices = append(s.prices, 0)
+		copy(s.prices[i+1:], s.prices[i:])
+		s.prices[i] = price
+	}
+}
+
+// search returns the index of price in the sorted prices, or where it would be inserted
+func (s *bookSide) search(price Decimal) int {
+	if s.descending {
+		return sort.Search(len(s.prices), func(i int) bool { return s.prices[i] <= price })
+	}
+	return sort.Search(len(s.prices), func(i int) bool { return s.prices[i] >= price })
+}
+
+// best returns the best level
+func (s *bookSide) best() (Level, bool) {
+	if len(s.prices) == 0 {
+		return Level{}, false
+	}
+	return Level{Price: s.prices[0], Size: s.sizes[s.prices[0]]}, true
+}
+
+// levels returns the best n levels, all for n <= 0
+func (s *bookSide) levels(n int) []Level {
+	if n <= 0 || n > len(s.prices) {
+		n = len(s.prices)
+	}
+
+	levels := make([]Level, n)
+	for i := 0; i < n; i++ {
+		levels[i] = Level{Price: s.prices[i], Size: s.sizes[s.prices[i]]}
+	}
+	return levels
+}
+
+// within returns the levels priced at or better than limit
+func (s *bookSide) within(limit Decimal) []Level {
+	var levels []Level
+	for _, price := range s.prices {
+		if (s.descending && price < limit) || (!s.descending && price > limit) {
+			break
+		}
+		levels = append(levels, Level{Price: price, Size: s.sizes[price]})
+	}
+	return levels
+}
+
+// summaries returns the levels as order summaries, best first
+func (s *bookSide) summaries() []types.OrderSummary {
+	summaries := make([]types.OrderSummary, len(s.prices))
+	for i, price := range s.prices {
+		summaries[i] = types.OrderSummary{Price: price.String(), Size: s.sizes[price].String()}
+	}
+	return summaries
+}
@@ -0,0 +1,340 @@
+package orderbook_test
+
+import (
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pooofdevelopment/go-clob-client/pkg/orderbook"
+	"github.com/pooofdevelopment/go-clob-client/pkg/types"
+	"github.com/pooofdevelopment/go-clob-client/pkg/websocket"
+)
+
+// fakeBookSource returns a fixed summary and counts the calls
+type fakeBookSource struct {
+	mu      sync.Mutex
+	summary *types.OrderBookSummary
+	err     error
+	calls   int
+	gate    chan struct{} // Holds the calls until closed, when set
+}
+
+func (s *fakeBookSource) GetOrderBook(tokenID string) (*types.OrderBookSummary, error) {
+	s.mu.Lock()
+	s.calls++
+	gate := s.gate
+	s.mu.Unlock()
+	if gate != nil {
+		<-gate
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err != nil {
+		return nil, s.err
+	}
+	summary := *s.summary
+	return &summary, nil
+}
+
+// bookSnapshot returns a book message for token 1234
+func bookSnapshot(timestamp string) *websocket.OrderBookUpdate {
+	return &websocket.OrderBookUpdate{
+		EventType: "book",
+		AssetID:   "1234",
+		Market:    "0xm",
+		Timestamp: timestamp,
+		Buys: []types.OrderSummary{
+			{Price: "0.48", Size: "100"},
+			{Price: "0.5", Size: "10"},
+			{Price: "0.49", Size: "20.5"},
+		},
+		Sells: []types.OrderSummary{
+			{Price: "0.55", Size: "30"},
+			{Price: "0.52", Size: "15"},
+		},
+	}
+}
+
+// priceChange returns a price_change message for token 1234
+func priceChange(timestamp string, changes ...websocket.PriceChange) *websocket.PriceChangeUpdate {
+	return &websocket.PriceChangeUpdate{
+		EventType: "price_change",
+		AssetID:   "1234",
+		Market:    "0xm",
+		Timestamp: timestamp,
+		Changes:   changes,
+	}
+}
+
+func newTestBook(t *testing.T, source orderbook.Source) *orderbook.OrderBook {
+	book, err := orderbook.NewOrderBook(orderbook.Config{
+		TokenID:  "1234",
+		TickSize: types.TickSize("0.01"),
+		Source:   source,
+	})
+	if err != nil {
+		t.Fatalf("NewOrderBook() failed: %v", err)
+	}
+	return book
+}
+
+// TestDecimal tests exact parsing and formatting
+func TestDecimal(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"0.52", "0.52"},
+		{"1500.250000", "1500.25"},
+		{".5", "0.5"},
+		{"-0.001", "-0.001"},
+		{"100", "100"},
+	}
+	for _, tt := range tests {
+		d, err := orderbook.ParseDecimal(tt.input)
+		if err != nil {
+			t.Fatalf("ParseDecimal(%q) failed: %v", tt.input, err)
+		}
+		if d.String() != tt.want {
+			t.Errorf("ParseDecimal(%q) = %s, want %s", tt.input, d, tt.want)
+		}
+	}
+
+	// 0.1 + 0.2 is exact, unlike with floats
+	sum := orderbook.MustParseDecimal("0.1") + orderbook.MustParseDecimal("0.2")
+	if sum != orderbook.MustParseDecimal("0.3") {
+		t.Errorf("0.1 + 0.2 = %s, want 0.3", sum)
+	}
+
+	for _, invalid := range []string{"", "abc", "0.1234567", "1.2.3"} {
+		if _, err := orderbook.ParseDecimal(invalid); err == nil {
+			t.Errorf("ParseDecimal(%q) should fail", invalid)
+		}
+	}
+}
+
+// TestOrderBook tests applying a snapshot and deltas, and the queries
+func TestOrderBook(t *testing.T) {
+	book := newTestBook(t, nil)
+
+	if err := book.ApplySnapshot(bookSnapshot("100")); err != nil {
+		t.Fatalf("ApplySnapshot() failed: %v", err)
+	}
+	if !book.Synced() {
+		t.Fatal("Book should be synced after a snapshot")
+	}
+
+	bid, ok := book.BestBid()
+	if !ok || bid.Price.String() != "0.5" || bid.Size.String() != "10" {
+		t.Errorf("BestBid() = %s @ %s, want 10 @ 0.5", bid.Size, bid.Price)
+	}
+	ask, ok := book.BestAsk()
+	if !ok || ask.Price.String() != "0.52" {
+		t.Errorf("BestAsk() = %s, want 0.52", ask.Price)
+	}
+
+	// Update a level, add a better bid and remove the best ask
+	err := book.ApplyPriceChange(priceChange("101",
+		websocket.PriceChange{Price: "0.49", Side: "BUY", Size: "5.25"},
+		websocket.PriceChange{Price: "0.51", Side: "BUY", Size: "1"},
+		websocket.PriceChange{Price: "0.52", Side: "SELL", Size: "0"},
+	))
+	if err != nil {
+		t.Fatalf("ApplyPriceChange() failed: %v", err)
+	}
+
+	bids, asks := book.Depth(0)
+	wantBids := []string{"0.51", "0.5", "0.49", "0.48"}
+	if len(bids) != len(wantBids) {
+		t.Fatalf("Depth() returned %d bids, want %d", len(bids), len(wantBids))
+	}
+	for i, price := range wantBids {
+		if bids[i].Price.String() != price {
+			t.Errorf("Bid %d = %s, want %s", i, bids[i].Price, price)
+		}
+	}
+	if bids[2].Size.String() != "5.25" {
+		t.Errorf("Bid 0.49 size = %s, want 5.25", bids[2].Size)
+	}
+	if len(asks) != 1 || asks[0].Price.String() != "0.55" {
+		t.Errorf("Depth() asks = %v, want only 0.55", asks)
+	}
+
+	spread, ok := book.Spread()
+	if !ok || spread.String() != "0.04" {
+		t.Errorf("Spread() = %s, want 0.04", spread)
+	}
+
+	// Levels at most 2 ticks from the best bid 0.51
+	bids, _ = book.LevelsWithinTicks(2)
+	if len(bids) != 3 || bids[2].Price.String() != "0.49" {
+		t.Errorf("LevelsWithinTicks(2) bids = %v, want 0.51 to 0.49", bids)
+	}
+
+	bids, _ = book.Depth(2)
+	if len(bids) != 2 {
+		t.Errorf("Depth(2) returned %d bids, want 2", len(bids))
+	}
+
+	// A delta older than the book is ignored
+	err = book.ApplyPriceChange(priceChange("99", websocket.PriceChange{Price: "0.51", Side: "BUY", Size: "0"}))
+	if err != nil {
+		t.Fatalf("ApplyPriceChange() failed: %v", err)
+	}
+	if bid, _ := book.BestBid(); bid.Price.String() != "0.51" {
+		t.Errorf("Stale delta was applied, best bid = %s", bid.Price)
+	}
+
+	// Deltas for other tokens are rejected
+	other := priceChange("102")
+	other.AssetID = "5678"
+	if err := book.ApplyPriceChange(other); err == nil {
+		t.Error("ApplyPriceChange() should reject another token")
+	}
+
+	summary := book.Summary()
+	if summary.AssetID != "1234" || summary.Bids[0].Price != "0.51" || summary.Asks[0].Price != "0.55" {
+		t.Errorf("Summary() = %+v", summary)
+	}
+}
+
+// TestOrderBookResync tests that a gap resyncs the book from the source and replays deltas
+func TestOrderBookResync(t *testing.T) {
+	source := &fakeBookSource{summary: &types.OrderBookSummary{
+		Market:    "0xm",
+		AssetID:   "1234",
+		Timestamp: "200",
+		Bids:      []types.OrderSummary{{Price: "0.4", Size: "50"}},
+		Asks:      []types.OrderSummary{{Price: "0.6", Size: "50"}},
+	}}
+	book := newTestBook(t, source)
+
+	// A delta before any snapshot triggers a resync; the delta is older than the fetched
+	// snapshot and is dropped
+	book.HandleEvent(websocket.Event{
+		Type:        websocket.EventPriceChange,
+		PriceChange: priceChange("150", websocket.PriceChange{Price: "0.45", Side: "BUY", Size: "1"}),
+	})
+
+	deadline := time.Now().Add(2 * time.Second)
+	for !book.Synced() {
+		if time.Now().After(deadline) {
+			t.Fatal("Book was not resynced")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if resyncs, err := book.Resyncs(); resyncs != 1 || err != nil {
+		t.Errorf("Resyncs() = %d, %v, want 1, nil", resyncs, err)
+	}
+	if bid, _ := book.BestBid(); bid.Price.String() != "0.4" {
+		t.Errorf("BestBid() = %s, want 0.4", bid.Price)
+	}
+
+	// A crossed book is out of sync
+	source.mu.Lock()
+	source.summary.Timestamp = "300"
+	source.mu.Unlock()
+	if err := book.ApplyPriceChange(priceChange("250", websocket.PriceChange{Price: "0.7", Side: "BUY", Size: "1"})); err != nil {
+		t.Fatalf("ApplyPriceChange() failed: %v", err)
+	}
+	if book.Synced() {
+		t.Error("Crossed book should be out of sync")
+	}
+
+	// A synchronous resync restores it
+	if err := book.Resync(); err != nil {
+		t.Fatalf("Resync() failed: %v", err)
+	}
+	if bid, _ := book.BestBid(); bid.Price.String() != "0.4" || !book.Synced() {
+		t.Errorf("BestBid() after Resync() = %s, want 0.4", bid.Price)
+	}
+
+	// A failing source leaves the book unsynced and reports the error
+	source.mu.Lock()
+	source.err = errors.New("unavailable")
+	source.mu.Unlock()
+	if err := book.Resync(); err == nil {
+		t.Error("Resync() should fail")
+	}
+	if book.Synced() || !book.Stale() {
+		t.Error("Book should be out of sync after a failed resync")
+	}
+}
+
+// TestOrderBookConcurrentResync tests that Resync joins a resync already in flight
+func TestOrderBookConcurrentResync(t *testing.T) {
+	source := &fakeBookSource{
+		summary: &types.OrderBookSummary{
+			AssetID:   "1234",
+			Timestamp: "200",
+			Bids:      []types.OrderSummary{{Price: "0.4", Size: "50"}},
+			Asks:      []types.OrderSummary{{Price: "0.6", Size: "50"}},
+		},
+		gate: make(chan struct{}),
+	}
+	book := newTestBook(t, source)
+
+	// A delta before the first snapshot starts a resync in the background
+	if err := book.ApplyPriceChange(priceChange("150", websocket.PriceChange{Price: "0.45", Side: "BUY", Size: "5"})); err != nil {
+		t.Fatalf("ApplyPriceChange() failed: %v", err)
+	}
+
+	done := make(chan error, 1)
+	go func() { done <- book.Resync() }()
+	select {
+	case err := <-done:
+		t.Fatalf("Resync() returned %v before the resync in flight completed", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(source.gate)
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("Resync() failed: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Resync() did not return")
+	}
+
+	source.mu.Lock()
+	calls := source.calls
+	source.mu.Unlock()
+	if calls != 1 {
+		t.Errorf("source called %d times, want 1", calls)
+	}
+	if resyncs, _ := book.Resyncs(); resyncs != 1 || !book.Synced() {
+		t.Errorf("Resyncs() = %d, synced %v, want one resync", resyncs, book.Synced())
+	}
+}
+
+// TestOrderBookMaxPending tests that deltas buffered without a source are bounded
+func TestOrderBookMaxPending(t *testing.T) {
+	book, err := orderbook.NewOrderBook(orderbook.Config{TokenID: "1234", TickSize: "0.01", MaxPending: 2})
+	if err != nil {
+		t.Fatalf("NewOrderBook() failed: %v", err)
+	}
+
+	for i, price := range []string{"0.44", "0.45", "0.46"} {
+		change := websocket.PriceChange{Price: price, Side: "BUY", Size: "1"}
+		if err := book.ApplyPriceChange(priceChange(strconv.Itoa(101+i), change)); err != nil {
+			t.Fatalf("ApplyPriceChange() failed: %v", err)
+		}
+	}
+	if err := book.ApplySnapshot(bookSnapshot("100")); err != nil {
+		t.Fatalf("ApplySnapshot() failed: %v", err)
+	}
+
+	bids, _ := book.Depth(10)
+	prices := map[string]bool{}
+	for _, level := range bids {
+		prices[level.Price.String()] = true
+	}
+	if prices["0.44"] || !prices["0.45"] || !prices["0.46"] {
+		t.Errorf("bids = %v, want only the 2 newest buffered deltas replayed", bids)
+	}
+}
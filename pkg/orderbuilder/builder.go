@@ -3,13 +3,16 @@ package orderbuilder
 import (
 	"fmt"
 	"math/big"
+	"strings"
 
 	"github.com/polymarket/go-order-utils/pkg/builder"
 	"github.com/polymarket/go-order-utils/pkg/model"
+	ordersigner "github.com/polymarket/go-order-utils/pkg/signer"
 	"github.com/pooofdevelopment/go-clob-client/pkg/config"
 	"github.com/pooofdevelopment/go-clob-client/pkg/signer"
 	"github.com/pooofdevelopment/go-clob-client/pkg/types"
 	"github.com/pooofdevelopment/go-clob-client/pkg/utilities"
+	"github.com/pooofdevelopment/go-clob-client/pkg/wallet"
 )
 
 // RoundingConfig maps tick sizes to rounding configurations
@@ -27,6 +30,7 @@ type OrderBuilder struct {
 	signer  *signer.Signer
 	sigType model.SignatureType
 	funder  string
+	derived string // Funder derived from the signer, empty when unknown
 }
 
 // NewOrderBuilder creates a new order builder
@@ -39,20 +43,35 @@ func NewOrderBuilder(s *signer.Signer, sigType *model.SignatureType, funder *str
 		st = *sigType
 	}
 
-	// Default funder to signer address
+	// Default funder to signer address for EOA orders and to the derived
+	// proxy/Safe wallet for POLY_PROXY and POLY_GNOSIS_SAFE orders
 	// Based on: py-clob-client-main/py_clob_client/order_builder/builder.py:48
 	f := s.Address()
+	derived, deriveErr := wallet.DeriveFunder(s.Address(), s.GetChainID(), st)
+	if deriveErr != nil {
+		derived = ""
+	}
 	if funder != nil {
 		f = *funder
+	} else if derived != "" {
+		f = derived
 	}
 
 	return &OrderBuilder{
 		signer:  s,
 		sigType: st,
 		funder:  f,
+		derived: derived,
 	}
 }
 
+// FunderMismatch reports whether an explicit funder differs from the wallet derived from
+// the signer for the signature type, and returns the derived wallet
+// Orders from a mismatched funder are usually rejected, so clients log a warning.
+func (ob *OrderBuilder) FunderMismatch() (string, bool) {
+	return ob.derived, ob.derived != "" && !strings.EqualFold(ob.funder, ob.derived)
+}
+
 // GetOrderAmounts calculates maker and taker amounts for a regular order
 // Based on: py-clob-client-main/py_clob_client/order_builder/builder.py:50-83
 func (ob *OrderBuilder) GetOrderAmounts(side string, size float64, price float64, roundConfig types.RoundConfig) (model.Side, *big.Int, *big.Int, error) {
@@ -147,6 +166,10 @@ func (ob *OrderBuilder) GetMarketOrderAmounts(side string, amount float64, price
 // CreateOrder creates and signs an order
 // Based on: py-clob-client-main/py_clob_client/order_builder/builder.py:118-155
 func (ob *OrderBuilder) CreateOrder(orderArgs *types.OrderArgs, options *types.CreateOrderOptions) (*model.SignedOrder, error) {
+	if !ob.signer.CanSign() {
+		return nil, fmt.Errorf("signer %s cannot sign orders", ob.signer.Address())
+	}
+
 	// Get order amounts
 	// Based on: py-clob-client-main/py_clob_client/order_builder/builder.py:124-129
 	side, makerAmount, takerAmount, err := ob.GetOrderAmounts(
@@ -184,29 +207,16 @@ func (ob *OrderBuilder) CreateOrder(orderArgs *types.OrderArgs, options *types.C
 
 	// Build signed order using go-order-utils
 	// Based on: py-clob-client-main/py_clob_client/order_builder/builder.py:149-154
-	chainID := big.NewInt(int64(ob.signer.GetChainID()))
-	orderBuilder := builder.NewExchangeOrderBuilderImpl(chainID, nil)
-
-	// Convert contract address to VerifyingContract
-	// Based on: go-order-utils-main/pkg/model/module.go:5-8
-	var verifyingContract model.VerifyingContract
-	if options.NegRisk {
-		verifyingContract = model.NegRiskCTFExchange
-	} else {
-		verifyingContract = model.CTFExchange
-	}
-
-	signedOrder, err := orderBuilder.BuildSignedOrder(ob.signer.GetPrivateKey(), orderData, verifyingContract)
-	if err != nil {
-		return nil, err
-	}
-
-	return signedOrder, nil
+	return ob.signOrder(orderData, options.NegRisk)
 }
 
 // CreateMarketOrder creates and signs a market order
 // Based on: py-clob-client-main/py_clob_client/order_builder/builder.py:157-194
 func (ob *OrderBuilder) CreateMarketOrder(orderArgs *types.MarketOrderArgs, options *types.CreateOrderOptions) (*model.SignedOrder, error) {
+	if !ob.signer.CanSign() {
+		return nil, fmt.Errorf("signer %s cannot sign orders", ob.signer.Address())
+	}
+
 	// Get market order amounts
 	// Based on: py-clob-client-main/py_clob_client/order_builder/builder.py:162-168
 	side, makerAmount, takerAmount, err := ob.GetMarketOrderAmounts(
@@ -244,24 +254,49 @@ func (ob *OrderBuilder) CreateMarketOrder(orderArgs *types.MarketOrderArgs, opti
 
 	// Build signed order using go-order-utils
 	// Based on: py-clob-client-main/py_clob_client/order_builder/builder.py:188-193
+	return ob.signOrder(orderData, options.NegRisk)
+}
+
+// signOrder builds the order and signs it with the private key, or through the backend of a
+// remote signer
+func (ob *OrderBuilder) signOrder(orderData *model.OrderData, negRisk bool) (*model.SignedOrder, error) {
 	chainID := big.NewInt(int64(ob.signer.GetChainID()))
 	orderBuilder := builder.NewExchangeOrderBuilderImpl(chainID, nil)
 
 	// Convert contract address to VerifyingContract
 	// Based on: go-order-utils-main/pkg/model/module.go:5-8
 	var verifyingContract model.VerifyingContract
-	if options.NegRisk {
+	if negRisk {
 		verifyingContract = model.NegRiskCTFExchange
 	} else {
 		verifyingContract = model.CTFExchange
 	}
 
-	signedOrder, err := orderBuilder.BuildSignedOrder(ob.signer.GetPrivateKey(), orderData, verifyingContract)
+	backend := ob.signer.Backend()
+	if backend == nil {
+		return orderBuilder.BuildSignedOrder(ob.signer.GetPrivateKey(), orderData, verifyingContract)
+	}
+
+	order, err := orderBuilder.BuildOrder(orderData)
 	if err != nil {
 		return nil, err
 	}
+	signature, err := backend.SignOrder(order, negRisk)
+	if err != nil {
+		return nil, fmt.Errorf("remote order signing failed: %w", err)
+	}
+
+	// Check the remote signature before handing the order out
+	orderHash, err := orderBuilder.BuildOrderHash(order, verifyingContract)
+	if err != nil {
+		return nil, err
+	}
+	valid, err := ordersigner.ValidateSignature(order.Signer, orderHash, signature)
+	if err != nil || !valid {
+		return nil, fmt.Errorf("remote signer returned an invalid order signature")
+	}
 
-	return signedOrder, nil
+	return &model.SignedOrder{Order: *order, Signature: signature}, nil
 }
 
 // CalculateBuyMarketPrice calculates the matching price for a buy order
@@ -300,3 +335,8 @@ func (ob *OrderBuilder) CalculateSellMarketPrice(positions []types.OrderSummary,
 func (ob *OrderBuilder) GetSignatureType() model.SignatureType {
 	return ob.sigType
 }
+
+// GetFunder returns the funder (maker) address used for orders
+func (ob *OrderBuilder) GetFunder() string {
+	return ob.funder
+}
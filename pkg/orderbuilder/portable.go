@@ -0,0 +1,194 @@
+package orderbuilder
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/polymarket/go-order-utils/pkg/builder"
+	"github.com/polymarket/go-order-utils/pkg/model"
+	ordersigner "github.com/polymarket/go-order-utils/pkg/signer"
+	"github.com/pooofdevelopment/go-clob-client/pkg/types"
+)
+
+// ToPortable converts a signed order to the portable format
+func ToPortable(order *model.SignedOrder, chainID int, options *types.CreateOrderOptions, orderType types.OrderType) *types.PortableSignedOrder {
+	portable := NewPortableOrder(&order.Order)
+	portable.Signature = "0x" + common.Bytes2Hex(order.Signature)
+
+	return &types.PortableSignedOrder{
+		Version:   types.SignedOrderFormatVersion,
+		ChainID:   chainID,
+		TickSize:  options.TickSize,
+		NegRisk:   options.NegRisk,
+		OrderType: orderType,
+		Order:     portable,
+		CreatedAt: time.Now().Unix(),
+	}
+}
+
+// NewPortableOrder converts an unsigned order to the portable format
+func NewPortableOrder(order *model.Order) types.PortableOrder {
+	side := types.BUY
+	if order.Side.Int64() == int64(model.SELL) {
+		side = types.SELL
+	}
+
+	return types.PortableOrder{
+		Salt:          order.Salt.String(),
+		Maker:         order.Maker.Hex(),
+		Signer:        order.Signer.Hex(),
+		Taker:         order.Taker.Hex(),
+		TokenID:       order.TokenId.String(),
+		MakerAmount:   order.MakerAmount.String(),
+		TakerAmount:   order.TakerAmount.String(),
+		Expiration:    order.Expiration.String(),
+		Nonce:         order.Nonce.String(),
+		FeeRateBps:    order.FeeRateBps.String(),
+		Side:          side,
+		SignatureType: int(order.SignatureType.Int64()),
+	}
+}
+
+// FromPortable parses a portable order and verifies its signature
+// The signature must be valid for the order's signer on the exchange selected by NegRisk
+func FromPortable(p *types.PortableSignedOrder) (*model.SignedOrder, error) {
+	if p.Version != types.SignedOrderFormatVersion {
+		return nil, fmt.Errorf("unsupported signed order version: %d", p.Version)
+	}
+	if _, ok := RoundingConfig[p.TickSize]; !ok {
+		return nil, fmt.Errorf("invalid tick size: %s", p.TickSize)
+	}
+
+	order, err := ParsePortableOrder(p.Order)
+	if err != nil {
+		return nil, err
+	}
+	signature := common.FromHex(p.Order.Signature)
+
+	// Verify the signature against the order hash
+	orderHash, err := HashOrder(order, p.ChainID, p.NegRisk)
+	if err != nil {
+		return nil, err
+	}
+	valid, err := ordersigner.ValidateSignature(order.Signer, orderHash, signature)
+	if err != nil {
+		return nil, fmt.Errorf("invalid order signature: %w", err)
+	}
+	if !valid {
+		return nil, fmt.Errorf("order signature does not match signer %s", p.Order.Signer)
+	}
+
+	return &model.SignedOrder{Order: *order, Signature: signature}, nil
+}
+
+// ParsePortableOrder parses the order fields of a portable order, ignoring its signature
+func ParsePortableOrder(o types.PortableOrder) (*model.Order, error) {
+	order := &model.Order{}
+
+	ints := []struct {
+		name  string
+		value string
+		dst   **big.Int
+	}{
+		{"salt", o.Salt, &order.Salt},
+		{"tokenId", o.TokenID, &order.TokenId},
+		{"makerAmount", o.MakerAmount, &order.MakerAmount},
+		{"takerAmount", o.TakerAmount, &order.TakerAmount},
+		{"expiration", o.Expiration, &order.Expiration},
+		{"nonce", o.Nonce, &order.Nonce},
+		{"feeRateBps", o.FeeRateBps, &order.FeeRateBps},
+	}
+	for _, f := range ints {
+		n, ok := new(big.Int).SetString(f.value, 10)
+		if !ok || n.Sign() < 0 {
+			return nil, fmt.Errorf("invalid %s: %q", f.name, f.value)
+		}
+		*f.dst = n
+	}
+
+	addresses := []struct {
+		name  string
+		value string
+		dst   *common.Address
+	}{
+		{"maker", o.Maker, &order.Maker},
+		{"signer", o.Signer, &order.Signer},
+		{"taker", o.Taker, &order.Taker},
+	}
+	for _, f := range addresses {
+		if !common.IsHexAddress(f.value) {
+			return nil, fmt.Errorf("invalid %s address: %q", f.name, f.value)
+		}
+		*f.dst = common.HexToAddress(f.value)
+	}
+
+	switch strings.ToUpper(o.Side) {
+	case types.BUY:
+		order.Side = big.NewInt(int64(model.BUY))
+	case types.SELL:
+		order.Side = big.NewInt(int64(model.SELL))
+	default:
+		return nil, fmt.Errorf("invalid side: %q", o.Side)
+	}
+
+	switch o.SignatureType {
+	case model.EOA, model.POLY_PROXY, model.POLY_GNOSIS_SAFE:
+		order.SignatureType = big.NewInt(int64(o.SignatureType))
+	default:
+		return nil, fmt.Errorf("invalid signature type: %d", o.SignatureType)
+	}
+
+	return order, nil
+}
+
+// HashOrder computes the EIP712 hash of an order on the exchange selected by negRisk
+func HashOrder(order *model.Order, chainID int, negRisk bool) (model.OrderHash, error) {
+	verifyingContract := model.CTFExchange
+	if negRisk {
+		verifyingContract = model.NegRiskCTFExchange
+	}
+
+	orderHash, err := builder.NewExchangeOrderBuilderImpl(big.NewInt(int64(chainID)), nil).BuildOrderHash(order, verifyingContract)
+	if err != nil {
+		return model.OrderHash{}, fmt.Errorf("failed to hash order: %w", err)
+	}
+	return orderHash, nil
+}
+
+// WriteSignedOrderFile writes portable signed orders to a JSON file
+func WriteSignedOrderFile(path string, orders []*types.PortableSignedOrder) error {
+	data, err := json.MarshalIndent(orders, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal signed orders: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write signed orders: %w", err)
+	}
+	return nil
+}
+
+// ReadSignedOrderFile reads portable signed orders from a JSON file holding an array of
+// orders or a single order
+func ReadSignedOrderFile(path string) ([]*types.PortableSignedOrder, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read signed orders: %w", err)
+	}
+
+	var orders []*types.PortableSignedOrder
+	if err := json.Unmarshal(data, &orders); err == nil {
+		return orders, nil
+	}
+
+	var order types.PortableSignedOrder
+	if err := json.Unmarshal(data, &order); err != nil {
+		return nil, fmt.Errorf("failed to parse signed orders: %w", err)
+	}
+	return []*types.PortableSignedOrder{&order}, nil
+}
@@ -0,0 +1,120 @@
+package remotesigner
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pooofdevelopment/go-clob-client/pkg/types"
+)
+
+// Audit entry kinds
+const (
+	AuditKindClobAuth = "clob_auth"
+	AuditKindOrder    = "order"
+)
+
+// AuditEntry is one line of the audit log
+// Every signature issued is recorded before it is returned, as are denied requests.
+type AuditEntry struct {
+	Time    time.Time `json:"time"`
+	Kind    string    `json:"kind"`
+	Signer  string    `json:"signer"`
+	Allowed bool      `json:"allowed"`
+	Reason  string    `json:"reason,omitempty"`
+	Remote  string    `json:"remote,omitempty"`
+
+	// ClobAuth requests
+	Timestamp int64 `json:"timestamp,omitempty"`
+	Nonce     int   `json:"nonce,omitempty"`
+
+	// Order requests
+	Order     *types.PortableOrder `json:"order,omitempty"`
+	NegRisk   bool                 `json:"neg_risk,omitempty"`
+	Notional  float64              `json:"notional,omitempty"`
+	OrderHash string               `json:"order_hash,omitempty"`
+
+	Signature string `json:"signature,omitempty"`
+}
+
+// AuditLog appends audit entries to a JSON lines file
+type AuditLog struct {
+	mu   sync.Mutex
+	file *os.File
+
+	// Notional of the orders signed per UTC day, restored from the file on open
+	daily map[string]float64
+}
+
+// OpenAuditLog opens or creates the audit log at path
+// Entries already in the file are read back so daily limits survive restarts.
+func OpenAuditLog(path string) (*AuditLog, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR|os.O_APPEND, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit log: %w", err)
+	}
+
+	log := &AuditLog{file: file, daily: make(map[string]float64)}
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var entry AuditEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			file.Close()
+			return nil, fmt.Errorf("failed to parse audit log: %w", err)
+		}
+		if entry.Kind == AuditKindOrder && entry.Allowed {
+			log.daily[auditDay(entry.Time)] += entry.Notional
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		file.Close()
+		return nil, fmt.Errorf("failed to read audit log: %w", err)
+	}
+
+	return log, nil
+}
+
+// Record appends an entry and syncs it to disk
+func (l *AuditLog) Record(entry *AuditEntry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to marshal audit entry: %w", err)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if _, err := l.file.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("failed to write audit entry: %w", err)
+	}
+	if err := l.file.Sync(); err != nil {
+		return fmt.Errorf("failed to sync audit log: %w", err)
+	}
+
+	if entry.Kind == AuditKindOrder && entry.Allowed {
+		l.daily[auditDay(entry.Time)] += entry.Notional
+	}
+	return nil
+}
+
+// DailyNotional returns the notional of the orders signed on the UTC day of t
+func (l *AuditLog) DailyNotional(t time.Time) float64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.daily[auditDay(t)]
+}
+
+// Close closes the audit log file
+func (l *AuditLog) Close() error {
+	return l.file.Close()
+}
+
+// auditDay returns the UTC day of t
+func auditDay(t time.Time) string {
+	return t.UTC().Format("2006-01-02")
+}
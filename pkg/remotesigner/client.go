@@ -0,0 +1,118 @@
+package remotesigner
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/polymarket/go-order-utils/pkg/model"
+	"github.com/pooofdevelopment/go-clob-client/pkg/httpclient"
+	"github.com/pooofdevelopment/go-clob-client/pkg/orderbuilder"
+	"github.com/pooofdevelopment/go-clob-client/pkg/signer"
+	"github.com/pooofdevelopment/go-clob-client/pkg/types"
+)
+
+// Client calls a signing daemon and implements signer.Backend
+type Client struct {
+	baseURL    string
+	token      types.Secret
+	httpClient *httpclient.Client
+}
+
+// NewClient creates a client for the signing daemon at endpoint: a Unix socket
+// ("unix:///path/to/socket") or an HTTP URL ("http://127.0.0.1:8790")
+// A non-empty token is sent as a bearer token.
+func NewClient(endpoint string, token string) *Client {
+	c := &Client{
+		baseURL: strings.TrimSuffix(endpoint, "/"),
+		token:   types.Secret(token),
+	}
+
+	if strings.HasPrefix(endpoint, unixSocketScheme) {
+		path := strings.TrimPrefix(endpoint, unixSocketScheme)
+		transport := &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				var d net.Dialer
+				return d.DialContext(ctx, "unix", path)
+			},
+		}
+		c.baseURL = "http://" + unixSocketHost
+		c.httpClient = httpclient.NewClientWithHTTPClient(&http.Client{Transport: transport})
+	} else {
+		c.httpClient = httpclient.NewClient()
+	}
+
+	return c
+}
+
+// NewSigner connects to the signing daemon at endpoint and returns a signer for its address
+// The signer can be used by ClobClient (see ClobClient.SetRemoteSigner) in place of a private key.
+func NewSigner(endpoint string, token string) (*signer.Signer, error) {
+	c := NewClient(endpoint, token)
+
+	address, chainID, err := c.Address()
+	if err != nil {
+		return nil, err
+	}
+	return signer.NewRemoteSigner(address, chainID, c)
+}
+
+// headers returns the request headers
+func (c *Client) headers() map[string]string {
+	if c.token == "" {
+		return nil
+	}
+	return map[string]string{"Authorization": "Bearer " + c.token.Reveal()}
+}
+
+// Address returns the address and chain ID of the daemon's key
+func (c *Client) Address() (string, int, error) {
+	response, err := c.httpClient.Get(c.baseURL+ADDRESS, c.headers())
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to get signer address: %w", err)
+	}
+
+	address, _ := response["address"].(string)
+	chainID, _ := response["chain_id"].(float64)
+	if !common.IsHexAddress(address) || chainID == 0 {
+		return "", 0, fmt.Errorf("invalid signer address response: %v", response)
+	}
+	return address, int(chainID), nil
+}
+
+// SignClobAuth implements signer.Backend
+func (c *Client) SignClobAuth(timestamp int64, nonce int) (string, error) {
+	response, err := c.httpClient.Post(c.baseURL+SIGN_CLOB_AUTH, c.headers(), clobAuthRequest{
+		Timestamp: timestamp,
+		Nonce:     nonce,
+	})
+	if err != nil {
+		return "", fmt.Errorf("remote auth signing failed: %w", err)
+	}
+
+	signature, _ := response["signature"].(string)
+	if signature == "" {
+		return "", fmt.Errorf("remote signer response is missing the signature")
+	}
+	return signature, nil
+}
+
+// SignOrder implements signer.Backend
+func (c *Client) SignOrder(order *model.Order, negRisk bool) ([]byte, error) {
+	response, err := c.httpClient.Post(c.baseURL+SIGN_ORDER, c.headers(), orderRequest{
+		Order:   orderbuilder.NewPortableOrder(order),
+		NegRisk: negRisk,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	signature, _ := response["signature"].(string)
+	if signature == "" {
+		return nil, fmt.Errorf("remote signer response is missing the signature")
+	}
+	return common.FromHex(signature), nil
+}
@@ -0,0 +1,116 @@
+package remotesigner
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/polymarket/go-order-utils/pkg/model"
+)
+
+// Policy restricts what a signing daemon signs
+// Empty lists and zero limits are not enforced. ClobAuth signing is off unless AllowClobAuth is set.
+type Policy struct {
+	// AllowClobAuth allows signing ClobAuth messages, which create and derive API keys
+	AllowClobAuth bool `json:"allow_clob_auth,omitempty"`
+
+	// AllowedTokens are the token IDs orders may trade
+	AllowedTokens []string `json:"allowed_tokens,omitempty"`
+
+	// AllowedMarkets maps condition IDs to their token IDs, all of which may be traded
+	// The daemon works offline, so markets have to list their tokens.
+	AllowedMarkets map[string][]string `json:"allowed_markets,omitempty"`
+
+	// MaxOrderNotional is the maximum USDC notional of a single order
+	MaxOrderNotional float64 `json:"max_order_notional,omitempty"`
+
+	// MaxDailyNotional is the maximum USDC notional signed per UTC day
+	MaxDailyNotional float64 `json:"max_daily_notional,omitempty"`
+
+	// AllowedSignatureTypes are the order signature types that may be signed
+	AllowedSignatureTypes []int `json:"allowed_signature_types,omitempty"`
+}
+
+// LoadPolicy reads a policy from a JSON file
+func LoadPolicy(path string) (*Policy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read policy: %w", err)
+	}
+
+	var policy Policy
+	if err := json.Unmarshal(data, &policy); err != nil {
+		return nil, fmt.Errorf("failed to parse policy: %w", err)
+	}
+	return &policy, nil
+}
+
+// tokenAllowed reports whether the policy allows trading a token
+func (p *Policy) tokenAllowed(tokenID string) bool {
+	if len(p.AllowedTokens) == 0 && len(p.AllowedMarkets) == 0 {
+		return true
+	}
+
+	for _, allowed := range p.AllowedTokens {
+		if allowed == tokenID {
+			return true
+		}
+	}
+	for _, tokens := range p.AllowedMarkets {
+		for _, allowed := range tokens {
+			if allowed == tokenID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// signatureTypeAllowed reports whether the policy allows an order signature type
+func (p *Policy) signatureTypeAllowed(signatureType int) bool {
+	if len(p.AllowedSignatureTypes) == 0 {
+		return true
+	}
+
+	for _, allowed := range p.AllowedSignatureTypes {
+		if allowed == signatureType {
+			return true
+		}
+	}
+	return false
+}
+
+// check returns why an order with the given notional may not be signed, or nil
+// dailyNotional is the notional already signed today
+func (p *Policy) check(order *model.Order, notional float64, dailyNotional float64) error {
+	if tokenID := order.TokenId.String(); !p.tokenAllowed(tokenID) {
+		return fmt.Errorf("token %s is not allowed", tokenID)
+	}
+
+	if signatureType := int(order.SignatureType.Int64()); !p.signatureTypeAllowed(signatureType) {
+		return fmt.Errorf("signature type %d is not allowed", signatureType)
+	}
+
+	if p.MaxOrderNotional > 0 && notional > p.MaxOrderNotional {
+		return fmt.Errorf("order notional %.6f exceeds the limit of %.6f", notional, p.MaxOrderNotional)
+	}
+
+	if p.MaxDailyNotional > 0 && dailyNotional+notional > p.MaxDailyNotional {
+		return fmt.Errorf("order notional %.6f exceeds the remaining daily limit of %.6f", notional, p.MaxDailyNotional-dailyNotional)
+	}
+
+	return nil
+}
+
+// orderNotional returns the USDC notional of an order: the maker amount of a buy and the
+// taker amount of a sell
+func orderNotional(order *model.Order) float64 {
+	amount := order.MakerAmount
+	if order.Side.Int64() == int64(model.SELL) {
+		amount = order.TakerAmount
+	}
+
+	notional, _ := new(big.Rat).SetFrac(amount, big.NewInt(1_000_000)).Float64()
+	return notional
+}
@@ -0,0 +1,206 @@
+package remotesigner_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pooofdevelopment/go-clob-client/pkg/client"
+	"github.com/pooofdevelopment/go-clob-client/pkg/headers"
+	"github.com/pooofdevelopment/go-clob-client/pkg/orderbuilder"
+	"github.com/pooofdevelopment/go-clob-client/pkg/remotesigner"
+	"github.com/pooofdevelopment/go-clob-client/pkg/signer"
+	"github.com/pooofdevelopment/go-clob-client/pkg/signing"
+	"github.com/pooofdevelopment/go-clob-client/pkg/types"
+)
+
+// TestRemoteSigner tests a ClobClient signing through a signing daemon with a policy
+func TestRemoteSigner(t *testing.T) {
+	privateKey := "0xac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80"
+	local, err := signer.NewSigner(privateKey, 137)
+	if err != nil {
+		t.Fatalf("NewSigner() failed: %v", err)
+	}
+
+	auditPath := filepath.Join(t.TempDir(), "audit.log")
+	audit, err := remotesigner.OpenAuditLog(auditPath)
+	if err != nil {
+		t.Fatalf("OpenAuditLog() failed: %v", err)
+	}
+	policy := &remotesigner.Policy{
+		AllowClobAuth:         true,
+		AllowedMarkets:        map[string][]string{"0xcondition": {"1234", "5678"}},
+		MaxOrderNotional:      10,
+		MaxDailyNotional:      12,
+		AllowedSignatureTypes: []int{0},
+	}
+	server, err := remotesigner.NewServer(local, policy, audit, "daemon-token")
+	if err != nil {
+		t.Fatalf("NewServer() failed: %v", err)
+	}
+
+	// Serve on a Unix socket as the daemon does
+	socket := "unix://" + filepath.Join(t.TempDir(), "signer.sock")
+	listener, err := remotesigner.Listen(socket)
+	if err != nil {
+		t.Fatalf("Listen() failed: %v", err)
+	}
+	go func() { _ = http.Serve(listener, server) }()
+	defer listener.Close()
+
+	if _, err := remotesigner.NewSigner(socket, "wrong-token"); err == nil {
+		t.Error("NewSigner() should fail with a wrong token")
+	}
+	remote, err := remotesigner.NewSigner(socket, "daemon-token")
+	if err != nil {
+		t.Fatalf("NewSigner() failed: %v", err)
+	}
+	if remote.Address() != local.Address() || !remote.CanSign() {
+		t.Fatalf("remote signer address = %s, want %s", remote.Address(), local.Address())
+	}
+
+	// ClobAuth signatures match local signing
+	want, _ := signing.SignClobAuthMessage(local, 1700000000, 3)
+	got, err := signing.SignClobAuthMessage(remote, 1700000000, 3)
+	if err != nil || got != want {
+		t.Errorf("SignClobAuthMessage() = %s, %v, want %s", got, err, want)
+	}
+	if _, err := headers.CreateLevel1Headers(remote, nil); err != nil {
+		t.Errorf("CreateLevel1Headers() failed: %v", err)
+	}
+
+	// Orders are signed through the daemon by the client
+	clob := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case types.GET_TICK_SIZE:
+			_, _ = w.Write([]byte(`{"minimum_tick_size":0.01}`))
+		case types.GET_NEG_RISK:
+			_, _ = w.Write([]byte(`{"neg_risk":false}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer clob.Close()
+
+	c, err := client.NewClobClient(clob.URL, 137, "", nil, nil, nil)
+	if err != nil {
+		t.Fatalf("NewClobClient() failed: %v", err)
+	}
+	if err := c.SetRemoteSigner(remote, nil, nil, nil); err != nil {
+		t.Fatalf("SetRemoteSigner() failed: %v", err)
+	}
+
+	order, err := c.CreateOrder(&types.OrderArgs{TokenID: "1234", Price: 0.5, Size: 16, Side: types.BUY}, nil)
+	if err != nil {
+		t.Fatalf("CreateOrder() failed: %v", err)
+	}
+	options := &types.CreateOrderOptions{TickSize: "0.01"}
+	if _, err := orderbuilder.FromPortable(orderbuilder.ToPortable(order, 137, options, types.OrderTypeGTC)); err != nil {
+		t.Errorf("remote order signature is invalid: %v", err)
+	}
+
+	denied := []struct {
+		name string
+		args *types.OrderArgs
+		want string
+	}{
+		{"token", &types.OrderArgs{TokenID: "9999", Price: 0.5, Size: 2, Side: types.BUY}, "not allowed"},
+		{"order notional", &types.OrderArgs{TokenID: "5678", Price: 0.5, Size: 30, Side: types.BUY}, "exceeds the limit"},
+		{"daily notional", &types.OrderArgs{TokenID: "5678", Price: 0.5, Size: 10, Side: types.SELL}, "daily limit"},
+	}
+	for _, tc := range denied {
+		if _, err := c.CreateOrder(tc.args, nil); err == nil || !strings.Contains(err.Error(), tc.want) {
+			t.Errorf("%s: CreateOrder() error = %v, want %q", tc.name, err, tc.want)
+		}
+	}
+
+	// Every request is in the audit log, and the daily total survives a restart
+	audit.Close()
+	file, err := os.Open(auditPath)
+	if err != nil {
+		t.Fatalf("failed to open audit log: %v", err)
+	}
+	defer file.Close()
+
+	var kinds []string
+	var signed int
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var entry remotesigner.AuditEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			t.Fatalf("invalid audit entry: %v", err)
+		}
+		kinds = append(kinds, entry.Kind)
+		if entry.Allowed && entry.Signature == "" {
+			t.Errorf("audit entry without signature: %+v", entry)
+		}
+		if entry.Allowed && entry.Kind == remotesigner.AuditKindOrder {
+			signed++
+		}
+	}
+	if len(kinds) != 6 || signed != 1 {
+		t.Errorf("audit log has %d entries (%v) with %d signed orders, want 6 with 1", len(kinds), kinds, signed)
+	}
+
+	reopened, err := remotesigner.OpenAuditLog(auditPath)
+	if err != nil {
+		t.Fatalf("OpenAuditLog() failed: %v", err)
+	}
+	defer reopened.Close()
+	if daily := reopened.DailyNotional(time.Now()); daily != 8 {
+		t.Errorf("DailyNotional() = %f, want 8", daily)
+	}
+}
+
+// TestRemoteSignerClobAuthDisabled tests that ClobAuth signing is denied and audited by default
+func TestRemoteSignerClobAuthDisabled(t *testing.T) {
+	local, err := signer.NewSigner("0xac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80", 137)
+	if err != nil {
+		t.Fatalf("NewSigner() failed: %v", err)
+	}
+
+	auditPath := filepath.Join(t.TempDir(), "audit.log")
+	audit, err := remotesigner.OpenAuditLog(auditPath)
+	if err != nil {
+		t.Fatalf("OpenAuditLog() failed: %v", err)
+	}
+	server, err := remotesigner.NewServer(local, &remotesigner.Policy{}, audit, "daemon-token")
+	if err != nil {
+		t.Fatalf("NewServer() failed: %v", err)
+	}
+
+	socket := "unix://" + filepath.Join(t.TempDir(), "signer.sock")
+	listener, err := remotesigner.Listen(socket)
+	if err != nil {
+		t.Fatalf("Listen() failed: %v", err)
+	}
+	go func() { _ = http.Serve(listener, server) }()
+	defer listener.Close()
+
+	remote, err := remotesigner.NewSigner(socket, "daemon-token")
+	if err != nil {
+		t.Fatalf("NewSigner() failed: %v", err)
+	}
+	if _, err := signing.SignClobAuthMessage(remote, 1700000000, 3); err == nil || !strings.Contains(err.Error(), "not allowed") {
+		t.Errorf("SignClobAuthMessage() error = %v, want a policy denial", err)
+	}
+
+	audit.Close()
+	data, err := os.ReadFile(auditPath)
+	if err != nil {
+		t.Fatalf("failed to read audit log: %v", err)
+	}
+	var entry remotesigner.AuditEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		t.Fatalf("invalid audit entry: %v", err)
+	}
+	if entry.Kind != remotesigner.AuditKindClobAuth || entry.Allowed || entry.Signature != "" || entry.Reason == "" {
+		t.Errorf("audit entry = %+v, want a denied ClobAuth request", entry)
+	}
+}
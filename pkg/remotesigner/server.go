@@ -0,0 +1,260 @@
+package remotesigner
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pooofdevelopment/go-clob-client/pkg/orderbuilder"
+	"github.com/pooofdevelopment/go-clob-client/pkg/signer"
+	"github.com/pooofdevelopment/go-clob-client/pkg/signing"
+	"github.com/pooofdevelopment/go-clob-client/pkg/types"
+)
+
+// Signing API endpoints
+const (
+	ADDRESS          = "/address"
+	SIGN_CLOB_AUTH   = "/sign/clob-auth"
+	SIGN_ORDER       = "/sign/order"
+	unixSocketHost   = "signer"
+	unixSocketScheme = "unix://"
+)
+
+// addressResponse is the response of the address endpoint
+type addressResponse struct {
+	Address string `json:"address"`
+	ChainID int    `json:"chain_id"`
+}
+
+// clobAuthRequest is the payload of the ClobAuth signing endpoint
+type clobAuthRequest struct {
+	Timestamp int64 `json:"timestamp"`
+	Nonce     int   `json:"nonce"`
+}
+
+// orderRequest is the payload of the order signing endpoint
+type orderRequest struct {
+	Order   types.PortableOrder `json:"order"`
+	NegRisk bool                `json:"neg_risk"`
+}
+
+// signatureResponse is the response of the signing endpoints
+type signatureResponse struct {
+	Signature string `json:"signature"`
+	OrderHash string `json:"order_hash,omitempty"`
+}
+
+// Server holds a private key and signs ClobAuth messages and orders allowed by its policy
+// Every request is recorded in the audit log; a signature is only returned once its audit
+// entry has been written.
+type Server struct {
+	signer *signer.Signer
+	policy *Policy
+	audit  *AuditLog
+	token  types.Secret
+
+	// Serializes order signing so the daily limit cannot be exceeded by concurrent requests
+	mu sync.Mutex
+}
+
+// NewServer creates a signing server
+// A non-empty token must be sent by clients as a bearer token.
+func NewServer(s *signer.Signer, policy *Policy, audit *AuditLog, token string) (*Server, error) {
+	if s == nil || s.GetPrivateKey() == nil {
+		return nil, fmt.Errorf("signing server requires a private key signer")
+	}
+	if policy == nil || audit == nil {
+		return nil, fmt.Errorf("signing server requires a policy and an audit log")
+	}
+
+	return &Server{
+		signer: s,
+		policy: policy,
+		audit:  audit,
+		token:  types.Secret(token),
+	}, nil
+}
+
+// ServeHTTP implements http.Handler
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if s.token != "" {
+		provided := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if subtle.ConstantTimeCompare([]byte(provided), []byte(s.token.Reveal())) != 1 {
+			writeError(w, http.StatusUnauthorized, "invalid token")
+			return
+		}
+	}
+
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == ADDRESS:
+		writeJSON(w, http.StatusOK, addressResponse{Address: s.signer.Address(), ChainID: s.signer.GetChainID()})
+	case r.Method == http.MethodPost && r.URL.Path == SIGN_CLOB_AUTH:
+		s.handleClobAuth(w, r)
+	case r.Method == http.MethodPost && r.URL.Path == SIGN_ORDER:
+		s.handleOrder(w, r)
+	default:
+		writeError(w, http.StatusNotFound, "not found")
+	}
+}
+
+// handleClobAuth signs a ClobAuth message when the policy allows it
+func (s *Server) handleClobAuth(w http.ResponseWriter, r *http.Request) {
+	var req clobAuthRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	entry := &AuditEntry{
+		Time:      time.Now(),
+		Kind:      AuditKindClobAuth,
+		Signer:    s.signer.Address(),
+		Remote:    r.RemoteAddr,
+		Timestamp: req.Timestamp,
+		Nonce:     req.Nonce,
+	}
+
+	// ClobAuth signatures create API keys, so they need their own switch
+	if !s.policy.AllowClobAuth {
+		entry.Reason = "ClobAuth signing is not allowed by the policy"
+		if err := s.audit.Record(entry); err != nil {
+			writeError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		writeError(w, http.StatusForbidden, entry.Reason)
+		return
+	}
+
+	signature, err := signing.SignClobAuthMessage(s.signer, req.Timestamp, req.Nonce)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	entry.Allowed = true
+	entry.Signature = signature
+	if err := s.audit.Record(entry); err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	writeJSON(w, http.StatusOK, signatureResponse{Signature: signature})
+}
+
+// handleOrder checks an order against the policy and signs it
+func (s *Server) handleOrder(w http.ResponseWriter, r *http.Request) {
+	var req orderRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	order, err := orderbuilder.ParsePortableOrder(req.Order)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	req.Order.Signature = ""
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	notional := orderNotional(order)
+	entry := &AuditEntry{
+		Time:     now,
+		Kind:     AuditKindOrder,
+		Signer:   s.signer.Address(),
+		Remote:   r.RemoteAddr,
+		Order:    &req.Order,
+		NegRisk:  req.NegRisk,
+		Notional: notional,
+	}
+
+	// Check the order against the policy and record denials
+	if !strings.EqualFold(order.Signer.Hex(), s.signer.Address()) {
+		err = fmt.Errorf("order signer %s is not %s", order.Signer.Hex(), s.signer.Address())
+	} else {
+		err = s.policy.check(order, notional, s.audit.DailyNotional(now))
+	}
+	if err != nil {
+		entry.Reason = err.Error()
+		if auditErr := s.audit.Record(entry); auditErr != nil {
+			writeError(w, http.StatusInternalServerError, auditErr.Error())
+			return
+		}
+		writeError(w, http.StatusForbidden, err.Error())
+		return
+	}
+
+	orderHash, err := orderbuilder.HashOrder(order, s.signer.GetChainID(), req.NegRisk)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	signature, err := s.signer.Sign(orderHash[:])
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	entry.Allowed = true
+	entry.OrderHash = common.Hash(orderHash).Hex()
+	entry.Signature = signature
+	if err := s.audit.Record(entry); err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	writeJSON(w, http.StatusOK, signatureResponse{Signature: signature, OrderHash: entry.OrderHash})
+}
+
+// Listen listens on a local endpoint: a Unix socket ("unix:///path/to/socket") or a loopback
+// TCP address ("127.0.0.1:8790")
+// A Unix socket is only accessible by the owner of the process.
+func Listen(endpoint string) (net.Listener, error) {
+	if strings.HasPrefix(endpoint, unixSocketScheme) {
+		path := strings.TrimPrefix(endpoint, unixSocketScheme)
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return nil, fmt.Errorf("failed to remove stale socket: %w", err)
+		}
+
+		listener, err := net.Listen("unix", path)
+		if err != nil {
+			return nil, err
+		}
+		if err := os.Chmod(path, 0o600); err != nil {
+			listener.Close()
+			return nil, fmt.Errorf("failed to restrict socket permissions: %w", err)
+		}
+		return listener, nil
+	}
+
+	host, _, err := net.SplitHostPort(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid endpoint %q: %w", endpoint, err)
+	}
+	if ip := net.ParseIP(host); host != "localhost" && (ip == nil || !ip.IsLoopback()) {
+		return nil, fmt.Errorf("endpoint %q is not a loopback address", endpoint)
+	}
+	return net.Listen("tcp", endpoint)
+}
+
+// writeJSON writes a JSON response
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes a JSON error response
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]string{"error": message})
+}
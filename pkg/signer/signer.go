@@ -7,6 +7,7 @@ import (
 	
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/polymarket/go-order-utils/pkg/model"
 )
 
 // Signer handles private key operations and signing
@@ -15,6 +16,19 @@ type Signer struct {
 	privateKey *ecdsa.PrivateKey
 	address    common.Address
 	chainID    int
+
+	// Signs in place of the private key when the key is held elsewhere, see NewRemoteSigner
+	backend Backend
+}
+
+// Backend signs on behalf of a signer whose private key is held elsewhere, e.g. by a signing daemon
+// A backend only signs structured messages, so it can check what it is asked to sign
+type Backend interface {
+	// SignClobAuth signs the CLOB authentication message for the given timestamp and nonce
+	SignClobAuth(timestamp int64, nonce int) (string, error)
+
+	// SignOrder signs an order for the exchange selected by negRisk
+	SignOrder(order *model.Order, negRisk bool) ([]byte, error)
 }
 
 // NewSigner creates a new signer from a private key string
@@ -49,6 +63,44 @@ func NewSigner(privateKeyHex string, chainID int) (*Signer, error) {
 	}, nil
 }
 
+// NewAddressSigner creates a signer that only knows an address and cannot sign
+// It allows Level 2 requests (which only need the address) without the private key, e.g. to
+// submit orders signed elsewhere
+func NewAddressSigner(address string, chainID int) (*Signer, error) {
+	if !common.IsHexAddress(address) || chainID == 0 {
+		return nil, fmt.Errorf("valid address and chain ID are required")
+	}
+
+	return &Signer{
+		address: common.HexToAddress(address),
+		chainID: chainID,
+	}, nil
+}
+
+// NewRemoteSigner creates a signer for address that signs through a backend
+func NewRemoteSigner(address string, chainID int, backend Backend) (*Signer, error) {
+	if backend == nil {
+		return nil, fmt.Errorf("signing backend is required")
+	}
+
+	s, err := NewAddressSigner(address, chainID)
+	if err != nil {
+		return nil, err
+	}
+	s.backend = backend
+	return s, nil
+}
+
+// CanSign reports whether the signer holds a private key or signs through a backend
+func (s *Signer) CanSign() bool {
+	return s.privateKey != nil || s.backend != nil
+}
+
+// Backend returns the signing backend of a remote signer, or nil
+func (s *Signer) Backend() Backend {
+	return s.backend
+}
+
 // Address returns the signer's address
 // Based on: py-clob-client-main/py_clob_client/signer.py:12-13
 func (s *Signer) Address() string {
@@ -65,6 +117,13 @@ func (s *Signer) GetChainID() int {
 // Based on: py-clob-client-main/py_clob_client/signer.py:18-23
 // Also uses: go-order-utils-main/pkg/signer/signer.go:12-19
 func (s *Signer) Sign(messageHash []byte) (string, error) {
+	if s.privateKey == nil {
+		if s.backend != nil {
+			return "", fmt.Errorf("signer %s signs remotely and cannot sign raw hashes", s.Address())
+		}
+		return "", fmt.Errorf("signer %s has no private key", s.Address())
+	}
+
 	// Convert to hash if needed
 	var hash common.Hash
 	if len(messageHash) == 32 {
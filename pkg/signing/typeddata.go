@@ -0,0 +1,382 @@
+package signing
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pooofdevelopment/go-clob-client/pkg/signer"
+)
+
+// TypedDataField is a member of an EIP-712 struct type
+type TypedDataField struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// TypedDataTypes maps EIP-712 struct type names to their members
+// The EIP712Domain type is derived from the domain and does not need to be listed
+type TypedDataTypes map[string][]TypedDataField
+
+// TypedData is an EIP-712 typed message
+// Message values may be Go natives (string, bool, integers, *big.Int, []byte), hex strings,
+// common.Address / common.Hash, nested map[string]interface{} for struct types and slices for arrays
+// Based on: https://eips.ethereum.org/EIPS/eip-712
+type TypedData struct {
+	Types       TypedDataTypes         `json:"types"`
+	PrimaryType string                 `json:"primaryType"`
+	Domain      EIP712Domain           `json:"domain"`
+	Message     map[string]interface{} `json:"message"`
+}
+
+// eip712DomainType is the name of the domain struct type
+const eip712DomainType = "EIP712Domain"
+
+// domainFields returns the EIP712Domain members present in the domain, in the standard order
+func (d EIP712Domain) domainFields() ([]TypedDataField, map[string]interface{}) {
+	var fields []TypedDataField
+	values := make(map[string]interface{})
+
+	if d.Name != "" {
+		fields = append(fields, TypedDataField{Name: "name", Type: "string"})
+		values["name"] = d.Name
+	}
+	if d.Version != "" {
+		fields = append(fields, TypedDataField{Name: "version", Type: "string"})
+		values["version"] = d.Version
+	}
+	if d.ChainID != nil {
+		fields = append(fields, TypedDataField{Name: "chainId", Type: "uint256"})
+		values["chainId"] = d.ChainID
+	}
+	if d.VerifyingContract != "" {
+		fields = append(fields, TypedDataField{Name: "verifyingContract", Type: "address"})
+		values["verifyingContract"] = d.VerifyingContract
+	}
+	if d.Salt != nil {
+		fields = append(fields, TypedDataField{Name: "salt", Type: "bytes32"})
+		values["salt"] = *d.Salt
+	}
+
+	return fields, values
+}
+
+// HashDomain returns the EIP-712 domain separator
+func HashDomain(domain EIP712Domain) (common.Hash, error) {
+	fields, values := domain.domainFields()
+	types := TypedDataTypes{eip712DomainType: fields}
+	return HashStruct(types, eip712DomainType, values)
+}
+
+// Hash returns the EIP-712 digest of the typed data, keccak256("\x19\x01" || domainSeparator || hashStruct(message))
+func (td *TypedData) Hash() (common.Hash, error) {
+	domainSeparator, err := HashDomain(td.Domain)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to hash domain: %w", err)
+	}
+
+	messageHash, err := HashStruct(td.Types, td.PrimaryType, td.Message)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to hash message: %w", err)
+	}
+
+	// Based on: go-order-utils-main/pkg/eip712/eip712.go:45-52
+	rawData := append([]byte("\x19\x01"), domainSeparator[:]...)
+	rawData = append(rawData, messageHash[:]...)
+	return crypto.Keccak256Hash(rawData), nil
+}
+
+// SignTypedData signs EIP-712 typed data with the signer
+func SignTypedData(s *signer.Signer, td *TypedData) (string, error) {
+	hash, err := td.Hash()
+	if err != nil {
+		return "", err
+	}
+	return s.Sign(hash.Bytes())
+}
+
+// EncodeType returns the EIP-712 type encoding of a struct type, followed by its referenced
+// struct types sorted by name
+func EncodeType(types TypedDataTypes, primaryType string) (string, error) {
+	if _, ok := types[primaryType]; !ok {
+		return "", fmt.Errorf("unknown type %s", primaryType)
+	}
+
+	deps := make(map[string]bool)
+	collectDependencies(types, primaryType, deps)
+	delete(deps, primaryType)
+
+	sorted := make([]string, 0, len(deps))
+	for dep := range deps {
+		sorted = append(sorted, dep)
+	}
+	sort.Strings(sorted)
+
+	var b strings.Builder
+	for _, name := range append([]string{primaryType}, sorted...) {
+		b.WriteString(name)
+		b.WriteString("(")
+		for i, field := range types[name] {
+			if i > 0 {
+				b.WriteString(",")
+			}
+			b.WriteString(field.Type)
+			b.WriteString(" ")
+			b.WriteString(field.Name)
+		}
+		b.WriteString(")")
+	}
+
+	return b.String(), nil
+}
+
+// collectDependencies adds the struct types referenced by typeName to deps
+func collectDependencies(types TypedDataTypes, typeName string, deps map[string]bool) {
+	typeName = baseType(typeName)
+	if deps[typeName] {
+		return
+	}
+	fields, ok := types[typeName]
+	if !ok {
+		return
+	}
+
+	deps[typeName] = true
+	for _, field := range fields {
+		collectDependencies(types, field.Type, deps)
+	}
+}
+
+// baseType strips array suffixes from a type, e.g. Person[][2] -> Person
+func baseType(typeName string) string {
+	if i := strings.Index(typeName, "["); i >= 0 {
+		return typeName[:i]
+	}
+	return typeName
+}
+
+// HashStruct returns hashStruct(message) for a struct type
+func HashStruct(types TypedDataTypes, primaryType string, data map[string]interface{}) (common.Hash, error) {
+	encoded, err := encodeData(types, primaryType, data)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return crypto.Keccak256Hash(encoded), nil
+}
+
+// encodeData encodes the type hash followed by the encoded members of a struct value
+func encodeData(types TypedDataTypes, primaryType string, data map[string]interface{}) ([]byte, error) {
+	typeEncoding, err := EncodeType(types, primaryType)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := types[primaryType]
+	encoded := make([]byte, 0, 32*(len(fields)+1))
+	encoded = append(encoded, crypto.Keccak256([]byte(typeEncoding))...)
+
+	for _, field := range fields {
+		value, ok := data[field.Name]
+		if !ok {
+			return nil, fmt.Errorf("%s is missing field %s", primaryType, field.Name)
+		}
+		word, err := encodeValue(types, field.Type, value)
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: %w", primaryType, field.Name, err)
+		}
+		encoded = append(encoded, word...)
+	}
+
+	return encoded, nil
+}
+
+// encodeValue encodes a single value of the given type to a 32 byte word
+func encodeValue(types TypedDataTypes, typeName string, value interface{}) ([]byte, error) {
+	// Arrays are the hash of their concatenated encoded elements
+	if strings.HasSuffix(typeName, "]") {
+		elemType := typeName[:strings.LastIndex(typeName, "[")]
+		items := reflect.ValueOf(value)
+		if items.Kind() != reflect.Slice && items.Kind() != reflect.Array {
+			return nil, fmt.Errorf("expected array for %s, got %T", typeName, value)
+		}
+		if n := arrayLength(typeName); n >= 0 && items.Len() != n {
+			return nil, fmt.Errorf("expected %d items for %s, got %d", n, typeName, items.Len())
+		}
+
+		encoded := make([]byte, 0, 32*items.Len())
+		for i := 0; i < items.Len(); i++ {
+			word, err := encodeValue(types, elemType, items.Index(i).Interface())
+			if err != nil {
+				return nil, fmt.Errorf("item %d: %w", i, err)
+			}
+			encoded = append(encoded, word...)
+		}
+		return crypto.Keccak256(encoded), nil
+	}
+
+	// Nested structs are encoded as their hashStruct
+	if _, ok := types[typeName]; ok {
+		data, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected map for %s, got %T", typeName, value)
+		}
+		hash, err := HashStruct(types, typeName, data)
+		if err != nil {
+			return nil, err
+		}
+		return hash.Bytes(), nil
+	}
+
+	switch {
+	case typeName == "string":
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected string, got %T", value)
+		}
+		return crypto.Keccak256([]byte(s)), nil
+
+	case typeName == "bytes":
+		b, err := toBytes(value)
+		if err != nil {
+			return nil, err
+		}
+		return crypto.Keccak256(b), nil
+
+	case strings.HasPrefix(typeName, "bytes"):
+		size, err := strconv.Atoi(typeName[len("bytes"):])
+		if err != nil || size < 1 || size > 32 {
+			return nil, fmt.Errorf("invalid type %s", typeName)
+		}
+		b, err := toBytes(value)
+		if err != nil {
+			return nil, err
+		}
+		if len(b) > size {
+			return nil, fmt.Errorf("expected at most %d bytes for %s, got %d", size, typeName, len(b))
+		}
+		return common.RightPadBytes(b, 32), nil
+
+	case typeName == "address":
+		switch v := value.(type) {
+		case common.Address:
+			return common.LeftPadBytes(v.Bytes(), 32), nil
+		case string:
+			if !common.IsHexAddress(v) {
+				return nil, fmt.Errorf("invalid address %s", v)
+			}
+			return common.LeftPadBytes(common.HexToAddress(v).Bytes(), 32), nil
+		default:
+			return nil, fmt.Errorf("expected address, got %T", value)
+		}
+
+	case typeName == "bool":
+		b, ok := value.(bool)
+		if !ok {
+			return nil, fmt.Errorf("expected bool, got %T", value)
+		}
+		word := make([]byte, 32)
+		if b {
+			word[31] = 1
+		}
+		return word, nil
+
+	case strings.HasPrefix(typeName, "uint"), strings.HasPrefix(typeName, "int"):
+		return encodeInteger(typeName, value)
+	}
+
+	return nil, fmt.Errorf("unsupported type %s", typeName)
+}
+
+// arrayLength returns the fixed length of an array type, or -1 for dynamic arrays
+func arrayLength(typeName string) int {
+	inner := typeName[strings.LastIndex(typeName, "[")+1 : len(typeName)-1]
+	if inner == "" {
+		return -1
+	}
+	n, err := strconv.Atoi(inner)
+	if err != nil {
+		return -1
+	}
+	return n
+}
+
+// toBytes converts a bytes value given as []byte, common.Hash or hex string
+func toBytes(value interface{}) ([]byte, error) {
+	switch v := value.(type) {
+	case []byte:
+		return v, nil
+	case common.Hash:
+		return v.Bytes(), nil
+	case string:
+		b, err := hexutil.Decode(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid hex bytes %q: %w", v, err)
+		}
+		return b, nil
+	default:
+		return nil, fmt.Errorf("expected bytes, got %T", value)
+	}
+}
+
+// encodeInteger encodes a uintN or intN value as a 32 byte two's complement word
+func encodeInteger(typeName string, value interface{}) ([]byte, error) {
+	signed := strings.HasPrefix(typeName, "int")
+	bitsStr := strings.TrimPrefix(strings.TrimPrefix(typeName, "u"), "int")
+	bits := 256
+	if bitsStr != "" {
+		var err error
+		bits, err = strconv.Atoi(bitsStr)
+		if err != nil || bits < 8 || bits > 256 || bits%8 != 0 {
+			return nil, fmt.Errorf("invalid type %s", typeName)
+		}
+	}
+
+	n := new(big.Int)
+	switch v := value.(type) {
+	case *big.Int:
+		if v == nil {
+			return nil, fmt.Errorf("nil integer")
+		}
+		n.Set(v)
+	case int:
+		n.SetInt64(int64(v))
+	case int64:
+		n.SetInt64(v)
+	case uint64:
+		n.SetUint64(v)
+	case float64:
+		// Numbers decoded from JSON
+		if v != float64(int64(v)) {
+			return nil, fmt.Errorf("non-integer value %v", v)
+		}
+		n.SetInt64(int64(v))
+	case string:
+		if _, ok := n.SetString(v, 0); !ok {
+			return nil, fmt.Errorf("invalid integer %q", v)
+		}
+	default:
+		return nil, fmt.Errorf("expected integer, got %T", value)
+	}
+
+	// Range check
+	if signed {
+		limit := new(big.Int).Lsh(big.NewInt(1), uint(bits-1))
+		if n.Cmp(limit) >= 0 || n.Cmp(new(big.Int).Neg(limit)) < 0 {
+			return nil, fmt.Errorf("value %s out of range for %s", n, typeName)
+		}
+	} else if n.Sign() < 0 || n.BitLen() > bits {
+		return nil, fmt.Errorf("value %s out of range for %s", n, typeName)
+	}
+
+	if n.Sign() < 0 {
+		// Two's complement over 256 bits
+		n.Add(n, new(big.Int).Lsh(big.NewInt(1), 256))
+	}
+	return common.LeftPadBytes(n.Bytes(), 32), nil
+}
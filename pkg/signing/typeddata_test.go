@@ -0,0 +1,210 @@
+package signing_test
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/pooofdevelopment/go-clob-client/pkg/signer"
+	"github.com/pooofdevelopment/go-clob-client/pkg/signing"
+)
+
+// mailTypedData is the example message from the EIP-712 specification
+func mailTypedData() *signing.TypedData {
+	return &signing.TypedData{
+		Types: signing.TypedDataTypes{
+			"Person": {
+				{Name: "name", Type: "string"},
+				{Name: "wallet", Type: "address"},
+			},
+			"Mail": {
+				{Name: "from", Type: "Person"},
+				{Name: "to", Type: "Person"},
+				{Name: "contents", Type: "string"},
+			},
+		},
+		PrimaryType: "Mail",
+		Domain: signing.EIP712Domain{
+			Name:              "Ether Mail",
+			Version:           "1",
+			ChainID:           big.NewInt(1),
+			VerifyingContract: "0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC",
+		},
+		Message: map[string]interface{}{
+			"from": map[string]interface{}{
+				"name":   "Cow",
+				"wallet": "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826",
+			},
+			"to": map[string]interface{}{
+				"name":   "Bob",
+				"wallet": "0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB",
+			},
+			"contents": "Hello, Bob!",
+		},
+	}
+}
+
+// TestTypedDataHash tests the EIP-712 encoder against the specification example
+func TestTypedDataHash(t *testing.T) {
+	td := mailTypedData()
+
+	encodedType, err := signing.EncodeType(td.Types, "Mail")
+	if err != nil {
+		t.Fatalf("EncodeType() failed: %v", err)
+	}
+	if want := "Mail(Person from,Person to,string contents)Person(string name,address wallet)"; encodedType != want {
+		t.Errorf("EncodeType() = %s, want %s", encodedType, want)
+	}
+
+	hash, err := td.Hash()
+	if err != nil {
+		t.Fatalf("Hash() failed: %v", err)
+	}
+	if want := "0xbe609aee343fb3c4b28e1df9e632fca64fcfaede20f02e86244efddf30957bd2"; hash.Hex() != want {
+		t.Errorf("Hash() = %s, want %s", hash.Hex(), want)
+	}
+}
+
+// TestTypedDataMatchesGeth tests arrays, bytes, signed integers and a salted domain against go-ethereum
+func TestTypedDataMatchesGeth(t *testing.T) {
+	salt := common.HexToHash("0x01")
+	td := &signing.TypedData{
+		Types: signing.TypedDataTypes{
+			"Call": {
+				{Name: "to", Type: "address"},
+				{Name: "data", Type: "bytes"},
+				{Name: "selector", Type: "bytes4"},
+				{Name: "delta", Type: "int64"},
+				{Name: "flags", Type: "bool[]"},
+				{Name: "amounts", Type: "uint256[]"},
+			},
+			"Batch": {
+				{Name: "calls", Type: "Call[]"},
+				{Name: "nonce", Type: "uint256"},
+			},
+		},
+		PrimaryType: "Batch",
+		Domain: signing.EIP712Domain{
+			Name:              "Relay",
+			ChainID:           big.NewInt(137),
+			VerifyingContract: "0xaB45c5A4B0c941a2F231C04C3f49182e1A254052",
+			Salt:              &salt,
+		},
+		Message: map[string]interface{}{
+			"calls": []interface{}{
+				map[string]interface{}{
+					"to":       "0x2791Bca1f2de4661ED88A30C99A7a9449Aa84174",
+					"data":     "0xa9059cbb0000",
+					"selector": "0xa9059cbb",
+					"delta":    -5,
+					"flags":    []interface{}{true, false},
+					"amounts":  []interface{}{big.NewInt(1), "1000000"},
+				},
+			},
+			"nonce": 42,
+		},
+	}
+
+	got, err := td.Hash()
+	if err != nil {
+		t.Fatalf("Hash() failed: %v", err)
+	}
+
+	reference := apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+				{Name: "verifyingContract", Type: "address"},
+				{Name: "salt", Type: "bytes32"},
+			},
+			"Call": {
+				{Name: "to", Type: "address"},
+				{Name: "data", Type: "bytes"},
+				{Name: "selector", Type: "bytes4"},
+				{Name: "delta", Type: "int64"},
+				{Name: "flags", Type: "bool[]"},
+				{Name: "amounts", Type: "uint256[]"},
+			},
+			"Batch": {
+				{Name: "calls", Type: "Call[]"},
+				{Name: "nonce", Type: "uint256"},
+			},
+		},
+		PrimaryType: "Batch",
+		Domain: apitypes.TypedDataDomain{
+			Name:              "Relay",
+			ChainId:           math.NewHexOrDecimal256(137),
+			VerifyingContract: "0xaB45c5A4B0c941a2F231C04C3f49182e1A254052",
+			Salt:              salt.Hex(),
+		},
+		Message: apitypes.TypedDataMessage{
+			"calls": []interface{}{
+				map[string]interface{}{
+					"to":       "0x2791Bca1f2de4661ED88A30C99A7a9449Aa84174",
+					"data":     "0xa9059cbb0000",
+					"selector": "0xa9059cbb",
+					"delta":    "-5",
+					"flags":    []interface{}{true, false},
+					"amounts":  []interface{}{"1", "1000000"},
+				},
+			},
+			"nonce": "42",
+		},
+	}
+	want, _, err := apitypes.TypedDataAndHash(reference)
+	if err != nil {
+		t.Fatalf("apitypes.TypedDataAndHash() failed: %v", err)
+	}
+
+	if got.Hex() != common.BytesToHash(want).Hex() {
+		t.Errorf("Hash() = %s, want %s", got.Hex(), common.BytesToHash(want).Hex())
+	}
+}
+
+// TestSignTypedData tests that typed data signatures recover to the signer
+func TestSignTypedData(t *testing.T) {
+	s, err := signer.NewSigner("0xac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80", 137)
+	if err != nil {
+		t.Fatalf("NewSigner() failed: %v", err)
+	}
+
+	auth := signing.ClobAuth{Address: s.Address(), Timestamp: "1234567890", Nonce: 0, Message: signing.MSG_TO_SIGN}
+	td := signing.ClobAuthTypedData(auth, 137)
+	signature, err := signing.SignTypedData(s, td)
+	if err != nil {
+		t.Fatalf("SignTypedData() failed: %v", err)
+	}
+
+	hash, _ := td.Hash()
+	sig := common.FromHex(signature)
+	if sig[64] >= 27 {
+		sig[64] -= 27
+	}
+	pub, err := crypto.SigToPub(hash.Bytes(), sig)
+	if err != nil {
+		t.Fatalf("SigToPub() failed: %v", err)
+	}
+	if recovered := strings.ToLower(crypto.PubkeyToAddress(*pub).Hex()); recovered != s.Address() {
+		t.Errorf("recovered %s, want %s", recovered, s.Address())
+	}
+
+	// The ClobAuth message is signed through the generic encoder
+	direct, err := signing.SignClobAuthMessage(s, 1234567890, 0)
+	if err != nil {
+		t.Fatalf("SignClobAuthMessage() failed: %v", err)
+	}
+	if direct != signature {
+		t.Errorf("SignClobAuthMessage() = %s, want %s", direct, signature)
+	}
+
+	// Missing fields are rejected
+	delete(td.Message, "nonce")
+	if _, err := td.Hash(); err == nil {
+		t.Error("Hash() should fail for a message with a missing field")
+	}
+}
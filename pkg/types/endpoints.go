@@ -15,6 +15,13 @@ const (
 	DERIVE_API_KEY = "/auth/derive-api-key"
 	CLOSED_ONLY    = "/auth/ban-status/closed-only"
 	
+	// Read-only API key endpoints
+	// Based on: clob-client-main/src/endpoints.ts
+	CREATE_READONLY_API_KEY   = "/auth/readonly-api-key"
+	GET_READONLY_API_KEYS     = "/auth/readonly-api-keys"
+	DELETE_READONLY_API_KEY   = "/auth/readonly-api-key"
+	VALIDATE_READONLY_API_KEY = "/auth/validate-readonly-api-key"
+	
 	// Trading data endpoints
 	// Based on: py-clob-client-main/py_clob_client/endpoints.py:7-11
 	TRADES        = "/data/trades"
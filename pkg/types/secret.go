@@ -0,0 +1,52 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+)
+
+// redacted is shown in place of a non-empty secret
+const redacted = "[REDACTED]"
+
+// Secret is a string that is masked whenever it is printed or logged
+// JSON keeps the real value, so credentials saved as JSON still load. Use Reveal to get the
+// real value where it has to be signed or sent (signatures, headers).
+type Secret string
+
+// Reveal returns the real secret value
+func (s Secret) Reveal() string {
+	return string(s)
+}
+
+// String returns the masked value
+func (s Secret) String() string {
+	if s == "" {
+		return ""
+	}
+	return redacted
+}
+
+// GoString returns the masked value for %#v
+func (s Secret) GoString() string {
+	return fmt.Sprintf("%q", s.String())
+}
+
+// Format masks the secret for every fmt verb
+func (s Secret) Format(f fmt.State, verb rune) {
+	if verb == 'v' && f.Flag('#') {
+		fmt.Fprint(f, s.GoString())
+		return
+	}
+	fmt.Fprintf(f, fmt.FormatString(f, verb), s.String())
+}
+
+// MarshalJSON encodes the real value
+func (s Secret) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(s))
+}
+
+// LogValue masks the secret in structured logs
+func (s Secret) LogValue() slog.Value {
+	return slog.StringValue(s.String())
+}
@@ -0,0 +1,49 @@
+package types_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/pooofdevelopment/go-clob-client/pkg/types"
+)
+
+// TestSecretRedaction tests that secrets are masked in every printed form and kept in JSON
+func TestSecretRedaction(t *testing.T) {
+	creds := types.ApiCreds{ApiKey: "key", ApiSecret: "top-secret", ApiPassphrase: "hunter2"}
+
+	outputs := []string{
+		fmt.Sprint(creds.ApiSecret),
+		fmt.Sprintf("%s %v %q %x", creds.ApiSecret, creds.ApiSecret, creds.ApiSecret, creds.ApiSecret),
+		fmt.Sprintf("%v", creds),
+		fmt.Sprintf("%+v", creds),
+		fmt.Sprintf("%#v", creds),
+	}
+	for _, out := range outputs {
+		if strings.Contains(out, "top-secret") || strings.Contains(out, "hunter2") ||
+			strings.Contains(out, fmt.Sprintf("%x", "top-secret")) {
+			t.Errorf("output leaks secret: %s", out)
+		}
+	}
+
+	if creds.ApiSecret.Reveal() != "top-secret" {
+		t.Errorf("Reveal() = %q, want top-secret", creds.ApiSecret.Reveal())
+	}
+
+	// Credentials saved as JSON round trip
+	data, err := json.Marshal(creds)
+	if err != nil {
+		t.Fatalf("json.Marshal() failed: %v", err)
+	}
+	if string(data) != `{"api_key":"key","api_secret":"top-secret","api_passphrase":"hunter2"}` {
+		t.Errorf("json.Marshal() = %s, want the real values", data)
+	}
+	var restored types.ApiCreds
+	if err := json.Unmarshal(data, &restored); err != nil {
+		t.Fatalf("json.Unmarshal() failed: %v", err)
+	}
+	if restored != creds {
+		t.Errorf("JSON round trip = %+v, want the original credentials", restored)
+	}
+}
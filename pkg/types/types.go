@@ -7,10 +7,11 @@ import (
 
 // ApiCreds represents API credentials for Level 2 authentication
 // Based on: py-clob-client-main/py_clob_client/clob_types.py:10-14
+// The secret and passphrase are masked when printed or logged, see Secret
 type ApiCreds struct {
 	ApiKey        string `json:"api_key"`
-	ApiSecret     string `json:"api_secret"`
-	ApiPassphrase string `json:"api_passphrase"`
+	ApiSecret     Secret `json:"api_secret"`
+	ApiPassphrase Secret `json:"api_passphrase"`
 }
 
 // RequestArgs represents arguments for building request headers
@@ -154,6 +155,38 @@ type CreateOrderOptions struct {
 	NegRisk  bool     `json:"neg_risk"`
 }
 
+// SignedOrderFormatVersion is the current version of the portable signed order format
+const SignedOrderFormatVersion = 1
+
+// PortableOrder is a signed order with every field encoded as a string, as sent to the API
+type PortableOrder struct {
+	Salt          string `json:"salt"`
+	Maker         string `json:"maker"`
+	Signer        string `json:"signer"`
+	Taker         string `json:"taker"`
+	TokenID       string `json:"tokenId"`
+	MakerAmount   string `json:"makerAmount"`
+	TakerAmount   string `json:"takerAmount"`
+	Expiration    string `json:"expiration"`
+	Nonce         string `json:"nonce"`
+	FeeRateBps    string `json:"feeRateBps"`
+	Side          string `json:"side"`          // BUY or SELL
+	SignatureType int    `json:"signatureType"` // 0 EOA, 1 POLY_PROXY, 2 POLY_GNOSIS_SAFE
+	Signature     string `json:"signature"`
+}
+
+// PortableSignedOrder is a signed order with everything needed to validate and submit it later,
+// on another machine
+type PortableSignedOrder struct {
+	Version   int           `json:"version"`
+	ChainID   int           `json:"chainId"`
+	TickSize  TickSize      `json:"tickSize"`
+	NegRisk   bool          `json:"negRisk"`
+	OrderType OrderType     `json:"orderType"`
+	Order     PortableOrder `json:"order"`
+	CreatedAt int64         `json:"createdAt"`
+}
+
 // PartialCreateOrderOptions represents optional order creation options
 // Based on: py-clob-client-main/py_clob_client/clob_types.py:204-207
 type PartialCreateOrderOptions struct {
@@ -178,6 +211,15 @@ type ContractConfig struct {
 	ConditionalTokens string `json:"conditional_tokens"` // The ERC1155 conditional tokens contract
 }
 
+// ProxyWalletConfig represents the factory parameters used to derive Polymarket proxy and Safe wallets
+// Based on: builder-relayer-client/src/config/index.ts and builder-relayer-client/src/builder/derive.ts
+type ProxyWalletConfig struct {
+	ProxyFactory      string `json:"proxy_factory"`        // Factory deploying POLY_PROXY wallets (empty if not deployed)
+	ProxyInitCodeHash string `json:"proxy_init_code_hash"` // keccak256 of the proxy wallet creation code
+	SafeFactory       string `json:"safe_factory"`         // Factory deploying POLY_GNOSIS_SAFE wallets
+	SafeInitCodeHash  string `json:"safe_init_code_hash"`  // keccak256 of the Safe proxy creation code
+}
+
 // Response represents a generic API response with pagination
 // Not directly in Python client but inferred from usage patterns
 type Response struct {
@@ -339,6 +381,7 @@ type GammaMarket struct {
 	Description     string   `json:"description"`
 	ConditionID     string   `json:"condition_id"`
 	ClobTokenIDs    []string `json:"clob_token_ids"`
+	Outcomes        []string `json:"outcomes"` // In the order of ClobTokenIDs
 	EnableOrderBook bool     `json:"enable_order_book"`
 	Question        string   `json:"question"`
 	OrderMinSize    float64  `json:"orderMinSize"`
@@ -27,6 +27,9 @@ func ParseRawOrderbookSummary(raw map[string]interface{}) (*types.OrderBookSumma
 	if timestamp, ok := raw["timestamp"].(string); ok {
 		obs.Timestamp = timestamp
 	}
+	if hash, ok := raw["hash"].(string); ok {
+		obs.Hash = hash
+	}
 	
 	// Parse bids
 	if bidsRaw, ok := raw["bids"].([]interface{}); ok {
@@ -0,0 +1,77 @@
+package wallet
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/polymarket/go-order-utils/pkg/model"
+	"github.com/pooofdevelopment/go-clob-client/pkg/config"
+)
+
+// DeriveProxyWallet derives the Polymarket proxy wallet (POLY_PROXY) address owned by the given EOA
+// Based on: builder-relayer-client/src/builder/derive.ts (deriveProxyWallet)
+func DeriveProxyWallet(address string, chainID int) (string, error) {
+	if !common.IsHexAddress(address) {
+		return "", fmt.Errorf("invalid address: %s", address)
+	}
+
+	walletConfig, err := config.GetProxyWalletConfig(chainID)
+	if err != nil {
+		return "", err
+	}
+	if walletConfig.ProxyFactory == "" {
+		return "", fmt.Errorf("proxy wallets are not supported on chainID: %d", chainID)
+	}
+
+	// The proxy factory salts CREATE2 with keccak256(abi.encodePacked(owner))
+	salt := crypto.Keccak256Hash(common.HexToAddress(address).Bytes())
+
+	return create2Address(walletConfig.ProxyFactory, salt, walletConfig.ProxyInitCodeHash), nil
+}
+
+// DeriveSafeWallet derives the Polymarket Gnosis Safe (POLY_GNOSIS_SAFE) address owned by the given EOA
+// Based on: builder-relayer-client/src/builder/derive.ts (deriveSafe)
+func DeriveSafeWallet(address string, chainID int) (string, error) {
+	if !common.IsHexAddress(address) {
+		return "", fmt.Errorf("invalid address: %s", address)
+	}
+
+	walletConfig, err := config.GetProxyWalletConfig(chainID)
+	if err != nil {
+		return "", err
+	}
+	if walletConfig.SafeFactory == "" {
+		return "", fmt.Errorf("safe wallets are not supported on chainID: %d", chainID)
+	}
+
+	// The Safe factory salts CREATE2 with keccak256(abi.encode(owner)), i.e. the address left-padded to 32 bytes
+	salt := crypto.Keccak256Hash(common.LeftPadBytes(common.HexToAddress(address).Bytes(), 32))
+
+	return create2Address(walletConfig.SafeFactory, salt, walletConfig.SafeInitCodeHash), nil
+}
+
+// DeriveFunder derives the funder (maker) address for the given signature type
+// EOA orders are funded by the signer itself, proxy and Safe orders by the derived wallet
+func DeriveFunder(address string, chainID int, sigType model.SignatureType) (string, error) {
+	switch sigType {
+	case model.EOA:
+		if !common.IsHexAddress(address) {
+			return "", fmt.Errorf("invalid address: %s", address)
+		}
+		return strings.ToLower(address), nil
+	case model.POLY_PROXY:
+		return DeriveProxyWallet(address, chainID)
+	case model.POLY_GNOSIS_SAFE:
+		return DeriveSafeWallet(address, chainID)
+	default:
+		return "", fmt.Errorf("unsupported signature type: %d", sigType)
+	}
+}
+
+// create2Address computes a CREATE2 address and returns it lowercased to match signer.Signer.Address
+func create2Address(factory string, salt common.Hash, initCodeHash string) string {
+	address := crypto.CreateAddress2(common.HexToAddress(factory), salt, common.FromHex(initCodeHash))
+	return strings.ToLower(address.Hex())
+}
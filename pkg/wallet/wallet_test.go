@@ -0,0 +1,113 @@
+package wallet_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/polymarket/go-order-utils/pkg/model"
+	"github.com/pooofdevelopment/go-clob-client/pkg/orderbuilder"
+	"github.com/pooofdevelopment/go-clob-client/pkg/signer"
+	"github.com/pooofdevelopment/go-clob-client/pkg/wallet"
+)
+
+// TestDeriveFunder tests deterministic proxy and Safe wallet derivation
+// Based on: builder-relayer-client/src/builder/derive.ts
+func TestDeriveFunder(t *testing.T) {
+	address := "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"
+
+	tests := []struct {
+		name    string
+		address string
+		chainID int
+		sigType model.SignatureType
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "EOA funds itself",
+			address: address,
+			chainID: 137,
+			sigType: model.EOA,
+			want:    "0xf39fd6e51aad88f6f4ce6ab8827279cfffb92266",
+		},
+		{
+			name:    "proxy wallet on Polygon",
+			address: address,
+			chainID: 137,
+			sigType: model.POLY_PROXY,
+			want:    "0x365f0ca36ae1f641e02fe3b7743673da42a13a70",
+		},
+		{
+			name:    "proxy wallet derivation ignores address case",
+			address: strings.ToLower(address),
+			chainID: 137,
+			sigType: model.POLY_PROXY,
+			want:    "0x365f0ca36ae1f641e02fe3b7743673da42a13a70",
+		},
+		{
+			name:    "Safe wallet on Polygon",
+			address: address,
+			chainID: 137,
+			sigType: model.POLY_GNOSIS_SAFE,
+			want:    "0xd93b25cb943d14d0d34fbaf01fc93a0f8b5f6e47",
+		},
+		{
+			name:    "no proxy factory on Amoy",
+			address: address,
+			chainID: 80002,
+			sigType: model.POLY_PROXY,
+			wantErr: true,
+		},
+		{
+			name:    "unknown chain",
+			address: address,
+			chainID: 1,
+			sigType: model.POLY_GNOSIS_SAFE,
+			wantErr: true,
+		},
+		{
+			name:    "invalid address",
+			address: "0x1234",
+			chainID: 137,
+			sigType: model.POLY_PROXY,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := wallet.DeriveFunder(tt.address, tt.chainID, tt.sigType)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("DeriveFunder() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("DeriveFunder() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestOrderBuilderDefaultFunder tests that the order builder uses the derived wallet when no funder is given
+func TestOrderBuilderDefaultFunder(t *testing.T) {
+	s, err := signer.NewSigner("0xac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80", 137)
+	if err != nil {
+		t.Fatalf("Failed to create signer: %v", err)
+	}
+
+	sigType := model.POLY_GNOSIS_SAFE
+	ob := orderbuilder.NewOrderBuilder(s, &sigType, nil)
+	if ob.GetFunder() != "0xd93b25cb943d14d0d34fbaf01fc93a0f8b5f6e47" {
+		t.Errorf("GetFunder() = %s, want derived Safe address", ob.GetFunder())
+	}
+
+	explicit := "0x8c1aec5b133aca324ff6d92083d8b7abd552727e"
+	ob = orderbuilder.NewOrderBuilder(s, &sigType, &explicit)
+	if ob.GetFunder() != explicit {
+		t.Errorf("GetFunder() = %s, want explicit funder %s", ob.GetFunder(), explicit)
+	}
+
+	ob = orderbuilder.NewOrderBuilder(s, nil, nil)
+	if ob.GetFunder() != s.Address() {
+		t.Errorf("GetFunder() = %s, want signer address %s", ob.GetFunder(), s.Address())
+	}
+}
@@ -0,0 +1,221 @@
+package websocket
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// CaptureRecord is one received frame of a capture file
+// A capture is gzip-compressed JSON lines, one record per frame in receive order.
+type CaptureRecord struct {
+	Time    time.Time `json:"time"`    // Receive time
+	Channel string    `json:"channel"` // "market" or "user"
+	Frame   string    `json:"frame"`   // Raw frame, as received
+}
+
+// Recorder writes received frames to a capture
+// A Recorder is safe for concurrent use, so the connections of a Pool can share one.
+type Recorder struct {
+	mu      sync.Mutex
+	gz      *gzip.Writer
+	encoder *json.Encoder
+	closer  io.Closer // The capture file, when created by CreateRecorder
+}
+
+// NewRecorder creates a recorder writing a capture to w
+func NewRecorder(w io.Writer) *Recorder {
+	gz := gzip.NewWriter(w)
+	return &Recorder{gz: gz, encoder: json.NewEncoder(gz)}
+}
+
+// CreateRecorder creates a capture file, truncating an existing one
+func CreateRecorder(path string) (*Recorder, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create capture file: %w", err)
+	}
+
+	r := NewRecorder(file)
+	r.closer = file
+	return r, nil
+}
+
+// Record appends a frame to the capture
+func (r *Recorder) Record(channel string, t time.Time, frame []byte) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.encoder == nil {
+		return fmt.Errorf("recorder is closed")
+	}
+	return r.encoder.Encode(CaptureRecord{Time: t, Channel: channel, Frame: string(frame)})
+}
+
+// Flush writes the buffered records, so the capture is readable while recording
+func (r *Recorder) Flush() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.encoder == nil {
+		return nil
+	}
+	return r.gz.Flush()
+}
+
+// Close ends the capture, closing the file of CreateRecorder
+func (r *Recorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.encoder == nil {
+		return nil
+	}
+	r.encoder = nil
+
+	err := r.gz.Close()
+	if r.closer != nil {
+		if closeErr := r.closer.Close(); err == nil {
+			err = closeErr
+		}
+	}
+	return err
+}
+
+// WithRecorder returns a ClientOption that tees every received frame into a capture
+// The recorder is not closed with the client.
+func WithRecorder(r *Recorder) ClientOption {
+	return func(c *Client) {
+		c.recorder = r
+	}
+}
+
+// ReplayConfig configures the replay of a capture
+type ReplayConfig struct {
+	// Speed is the replay rate relative to the capture: 1 replays in real time, 10 ten
+	// times faster, and 0 as fast as possible
+	Speed float64
+
+	// Channel only replays the frames of one channel when set
+	Channel string
+}
+
+// CaptureReader reads the records of a capture
+type CaptureReader struct {
+	decoder *json.Decoder
+	closer  io.Closer
+}
+
+// NewCaptureReader reads a capture from r; plain JSON lines are accepted as well
+func NewCaptureReader(r io.Reader) (*CaptureReader, error) {
+	buffered := bufio.NewReader(r)
+	magic, _ := buffered.Peek(2)
+	if len(magic) == 2 && magic[0] == 0x1f && magic[1] == 0x8b {
+		gz, err := gzip.NewReader(buffered)
+		if err != nil {
+			return nil, fmt.Errorf("invalid capture: %w", err)
+		}
+		return &CaptureReader{decoder: json.NewDecoder(gz)}, nil
+	}
+	return &CaptureReader{decoder: json.NewDecoder(buffered)}, nil
+}
+
+// OpenCapture opens a capture file
+func OpenCapture(path string) (*CaptureReader, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open capture file: %w", err)
+	}
+
+	reader, err := NewCaptureReader(file)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	reader.closer = file
+	return reader, nil
+}
+
+// Next returns the next record, or io.EOF at the end of the capture
+func (r *CaptureReader) Next() (*CaptureRecord, error) {
+	var record CaptureRecord
+	if err := r.decoder.Decode(&record); err != nil {
+		if err == io.EOF {
+			return nil, io.EOF
+		}
+		return nil, fmt.Errorf("invalid capture record: %w", err)
+	}
+	return &record, nil
+}
+
+// Close closes the file of OpenCapture
+func (r *CaptureReader) Close() error {
+	if r.closer != nil {
+		return r.closer.Close()
+	}
+	return nil
+}
+
+// Replay feeds a capture through the parsing of a client into a handler, keeping the
+// recorded pacing scaled by ReplayConfig.Speed, until the end of the capture or ctx is done
+// The handler receives the same calls as from a live connection, without connection events;
+// opts configure the replaying client, e.g. WithLogger.
+func Replay(ctx context.Context, r *CaptureReader, handler MessageHandler, config ReplayConfig, opts ...ClientOption) error {
+	c := NewClientWithOptions("", handler, append(opts, WithoutReconnect())...)
+	defer c.Close()
+
+	var first time.Time
+	start := time.Now()
+	for {
+		record, err := r.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if config.Channel != "" && record.Channel != config.Channel {
+			continue
+		}
+
+		if config.Speed > 0 {
+			if first.IsZero() {
+				first = record.Time
+			}
+			offset := time.Duration(float64(record.Time.Sub(first)) / config.Speed)
+			if wait := time.Until(start.Add(offset)); wait > 0 {
+				timer := time.NewTimer(wait)
+				select {
+				case <-ctx.Done():
+					timer.Stop()
+					return ctx.Err()
+				case <-timer.C:
+				}
+			}
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		c.handleFrame([]byte(record.Frame))
+	}
+}
+
+// ReplayEvents replays a capture onto an event stream, closed at the end of the replay
+// A replay error is reported by EventStream.Err.
+func ReplayEvents(ctx context.Context, r *CaptureReader, config ReplayConfig, stream StreamConfig) *EventStream {
+	events := NewEventStream(stream)
+	go func() {
+		defer events.close()
+		if err := Replay(ctx, r, events, config); err != nil {
+			events.err.CompareAndSwap(nil, &err)
+		}
+	}()
+	return events
+}
@@ -0,0 +1,115 @@
+package websocket_test
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pooofdevelopment/go-clob-client/pkg/websocket"
+)
+
+// TestWebsocketCapture tests recording a live feed and replaying it onto an event stream
+func TestWebsocketCapture(t *testing.T) {
+	sent := make(chan struct{})
+	server := newFeedServer(priceChangeMessages(3), sent)
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "feed.jsonl.gz")
+	recorder, err := websocket.CreateRecorder(path)
+	if err != nil {
+		t.Fatalf("CreateRecorder() failed: %v", err)
+	}
+
+	handler := &countingHandler{}
+	client := websocket.NewClientWithOptions(server.URL, handler, websocket.WithoutReconnect(), websocket.WithRecorder(recorder))
+	if err := client.SubscribeToMarket([]string{"1234"}, true); err != nil {
+		t.Fatalf("SubscribeToMarket() failed: %v", err)
+	}
+	<-sent
+	deadline := time.Now().Add(5 * time.Second)
+	for atomic.LoadInt32(&handler.priceChanges) < 3 {
+		if time.Now().After(deadline) {
+			t.Fatal("live price changes were not received")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	_ = client.Close()
+	if err := recorder.Close(); err != nil {
+		t.Fatalf("Close() failed: %v", err)
+	}
+
+	capture, err := websocket.OpenCapture(path)
+	if err != nil {
+		t.Fatalf("OpenCapture() failed: %v", err)
+	}
+	defer capture.Close()
+
+	stream := websocket.ReplayEvents(context.Background(), capture, websocket.ReplayConfig{Channel: "market"}, websocket.StreamConfig{})
+	var timestamps []string
+	for event := range stream.Events() {
+		if event.Type == websocket.EventPriceChange {
+			timestamps = append(timestamps, event.PriceChange.Timestamp)
+		}
+	}
+	if err := stream.Err(); err != nil {
+		t.Fatalf("replay failed: %v", err)
+	}
+	if len(timestamps) != 3 || timestamps[0] != "0" || timestamps[2] != "2" {
+		t.Errorf("replayed timestamps = %v, want 0 to 2", timestamps)
+	}
+}
+
+// TestWebsocketReplaySpeed tests that a replay keeps the recorded pacing scaled by the speed
+func TestWebsocketReplaySpeed(t *testing.T) {
+	var buf bytes.Buffer
+	recorder := websocket.NewRecorder(&buf)
+	start := time.Now()
+	for i, msg := range priceChangeMessages(2) {
+		if err := recorder.Record("market", start.Add(time.Duration(i)*400*time.Millisecond), []byte(msg)); err != nil {
+			t.Fatalf("Record() failed: %v", err)
+		}
+	}
+	_ = recorder.Record("market", start.Add(400*time.Millisecond), []byte("PONG"))
+	if err := recorder.Close(); err != nil {
+		t.Fatalf("Close() failed: %v", err)
+	}
+	data := buf.Bytes()
+
+	for _, tt := range []struct {
+		speed    float64
+		min, max time.Duration
+	}{
+		{speed: 4, min: 90 * time.Millisecond, max: 350 * time.Millisecond},
+		{speed: 0, min: 0, max: 50 * time.Millisecond},
+	} {
+		capture, err := websocket.NewCaptureReader(bytes.NewReader(data))
+		if err != nil {
+			t.Fatalf("NewCaptureReader() failed: %v", err)
+		}
+
+		handler := &countingHandler{}
+		began := time.Now()
+		if err := websocket.Replay(context.Background(), capture, handler, websocket.ReplayConfig{Speed: tt.speed}); err != nil {
+			t.Fatalf("Replay() failed: %v", err)
+		}
+		elapsed := time.Since(began)
+
+		if handler.priceChanges != 2 {
+			t.Errorf("speed %v: replayed %d price changes, want 2", tt.speed, handler.priceChanges)
+		}
+		if elapsed < tt.min || elapsed > tt.max {
+			t.Errorf("speed %v: replay took %s, want %s to %s", tt.speed, elapsed, tt.min, tt.max)
+		}
+	}
+
+	// A cancelled replay stops
+	capture, _ := websocket.NewCaptureReader(bytes.NewReader(data))
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := websocket.Replay(ctx, capture, &countingHandler{}, websocket.ReplayConfig{Speed: 1}); err == nil {
+		t.Error("cancelled Replay() should fail")
+	}
+}
@@ -4,12 +4,15 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"log"
+	"log/slog"
+	"net/http"
 	"net/url"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/gorilla/websocket"
+	"github.com/pooofdevelopment/go-clob-client/pkg/logging"
 	"github.com/pooofdevelopment/go-clob-client/pkg/types"
 	"github.com/pooofdevelopment/go-clob-client/pkg/utilities"
 )
@@ -34,11 +37,12 @@ type SubscriptionMessage struct {
 }
 
 // AuthMessage represents authentication credentials for user subscriptions
+// The secret and passphrase are masked when the message is printed or logged
 // Based on: clob-client-main/examples/socketConnection.ts:18
 type AuthMessage struct {
-	APIKey     string `json:"apiKey"`
-	Secret     string `json:"secret"`
-	Passphrase string `json:"passphrase"`
+	APIKey     string       `json:"apiKey"`
+	Secret     types.Secret `json:"secret"`
+	Passphrase types.Secret `json:"passphrase"`
 }
 
 // OrderBookUpdate represents a real-time orderbook update
@@ -77,6 +81,11 @@ type PriceChange struct {
 	Price string `json:"price"`
 	Side  string `json:"side"` // "BUY" or "SELL"
 	Size  string `json:"size"`
+
+	// Only sent in the batched price_changes format
+	Hash    string `json:"hash,omitempty"`     // Book hash after the change
+	BestBid string `json:"best_bid,omitempty"` // Top of book after the change
+	BestAsk string `json:"best_ask,omitempty"`
 }
 
 // TickSizeChangeUpdate represents a tick size change event
@@ -107,6 +116,10 @@ type UserUpdate struct {
 	Type      string                 `json:"type"`
 	Data      map[string]interface{} `json:"data"`
 	Timestamp int64                  `json:"timestamp"`
+
+	// Message is the whole message, e.g. the order or trade fields, when it came from
+	// an order or trade event; nil otherwise
+	Message map[string]interface{} `json:"-"`
 }
 
 // MessageHandler defines the interface for handling websocket messages
@@ -122,40 +135,152 @@ type MessageHandler interface {
 }
 
 // Client represents a websocket client for Polymarket CLOB
+// A dropped connection is re-established with backoff and the active subscriptions are
+// replayed with an initial dump, see ReconnectConfig.
 // Based on: clob-client-main/examples/socketConnection.ts
 type Client struct {
-	host             string
-	conn             *websocket.Conn
-	handler          MessageHandler
-	ctx              context.Context
-	cancel           context.CancelFunc
-	mu               sync.RWMutex
-	isConnected      bool
-	pingTicker       *time.Ticker
+	host              string
+	conn              *websocket.Conn
+	handler           MessageHandler
+	ctx               context.Context
+	cancel            context.CancelFunc
+	mu                sync.RWMutex
+	writeMu           sync.Mutex // Serializes writes, a connection supports one concurrent writer
+	isConnected       bool
 	lastOrderbookHash string // Track last orderbook to avoid duplicate empty updates
+
+	// Channel of the connection and its subscription set, replayed after a reconnect
+	channel       string
+	subscriptions *subscriptionSet
+	subMu         sync.Mutex // Serializes subscription changes with their replay
+
+	state         ConnectionState
+	onStateChange func(ConnectionState)
+	reconnect     *ReconnectConfig // nil disables reconnection
+	liveness      LivenessConfig
+
+	// Event stream of a client created by NewEventClient, closed with the client
+	stream *EventStream
+
+	// Structured logger, silent by default, see WithLogger
+	logger *slog.Logger
+
+	// Unix nanoseconds of the last PING, PONG and message, see LivenessConfig
+	lastPing    atomic.Int64
+	lastPong    atomic.Int64
+	lastMessage atomic.Int64
+
+	// Optional capture of the received frames, see WithRecorder
+	recorder *Recorder
+
+	// Dialer and extra handshake headers, see WithDialer and WithHeaders
+	dialer *websocket.Dialer
+	header http.Header
 }
 
-// NewClient creates a new websocket client
+// ClientOption is a functional option for configuring the websocket Client
+type ClientOption func(*Client)
+
+// WithReconnect returns a ClientOption that sets the reconnection settings
+func WithReconnect(config ReconnectConfig) ClientOption {
+	return func(c *Client) {
+		c.reconnect = &config
+	}
+}
+
+// WithoutReconnect returns a ClientOption that disables reconnection
+// The client closes for good when the connection drops, as reported by OnDisconnect.
+func WithoutReconnect() ClientOption {
+	return func(c *Client) {
+		c.reconnect = nil
+	}
+}
+
+// WithStateHandler returns a ClientOption that is called on every connection state change
+func WithStateHandler(fn func(ConnectionState)) ClientOption {
+	return func(c *Client) {
+		c.onStateChange = fn
+	}
+}
+
+// WithLogger returns a ClientOption that sets the structured logger of the client
+// Connection changes are logged at info level, failures at warn level and pings,
+// subscriptions and messages at debug level. Without a logger the client is silent.
+func WithLogger(logger *slog.Logger) ClientOption {
+	return func(c *Client) {
+		c.logger = logging.OrDiscard(logger)
+	}
+}
+
+// NewClient creates a new websocket client with the default reconnection and liveness settings
 func NewClient(host string, handler MessageHandler) *Client {
+	return NewClientWithOptions(host, handler)
+}
+
+// NewClientWithOptions creates a new websocket client with custom options
+func NewClientWithOptions(host string, handler MessageHandler, opts ...ClientOption) *Client {
 	ctx, cancel := context.WithCancel(context.Background())
-	return &Client{
-		host:    host,
-		handler: handler,
-		ctx:     ctx,
-		cancel:  cancel,
+	reconnect := DefaultReconnectConfig()
+	c := &Client{
+		host:      host,
+		handler:   handler,
+		ctx:       ctx,
+		cancel:    cancel,
+		state:     StateConnecting,
+		reconnect: &reconnect,
+		liveness:  DefaultLivenessConfig(),
+		logger:    logging.Discard(),
+		dialer:    defaultDialer(),
+	}
+
+	for _, opt := range opts {
+		opt(c)
 	}
+
+	return c
 }
 
-// Connect establishes a websocket connection to a specific channel
-// Based on: clob-client-main/examples/socketConnection.ts:32
-func (c *Client) connectToChannel(channel string) error {
+// State returns the current connection state
+func (c *Client) State() ConnectionState {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.state
+}
+
+// setState changes the connection state and notifies the state handler
+// The closed state is final.
+func (c *Client) setState(state ConnectionState) {
 	c.mu.Lock()
-	defer c.mu.Unlock()
+	if c.state == state || c.state == StateClosed {
+		c.mu.Unlock()
+		return
+	}
+	c.state = state
+	fn := c.onStateChange
+	c.mu.Unlock()
+
+	if fn != nil {
+		fn(state)
+	}
 
+	if c.stream != nil {
+		// The consumer may have stopped reading, so closing must not wait for room
+		if state == StateClosed {
+			c.stream.offer(Event{Type: EventState, State: state})
+			c.stream.close()
+			return
+		}
+		c.stream.send(Event{Type: EventState, State: state})
+	}
+}
+
+// dial opens a websocket connection to a channel
+// Based on: clob-client-main/examples/socketConnection.ts:32
+func (c *Client) dial(channel string) (*websocket.Conn, error) {
 	// Parse the URL and convert to websocket scheme
 	u, err := url.Parse(c.host)
 	if err != nil {
-		return fmt.Errorf("invalid host URL: %w", err)
+		return nil, fmt.Errorf("invalid host URL: %w", err)
 	}
 
 	// Convert HTTP/HTTPS to WS/WSS
@@ -167,35 +292,58 @@ func (c *Client) connectToChannel(channel string) error {
 	case "ws", "wss":
 		// Already correct
 	default:
-		return fmt.Errorf("unsupported URL scheme: %s", u.Scheme)
+		return nil, fmt.Errorf("unsupported URL scheme: %s", u.Scheme)
 	}
 
 	// Append the channel path
 	u.Path = fmt.Sprintf("/ws/%s", channel)
-	
-	dialer := websocket.DefaultDialer
-	dialer.HandshakeTimeout = 10 * time.Second
 
-	conn, _, err := dialer.Dial(u.String(), nil)
+	start := time.Now()
+	conn, _, err := c.dialer.DialContext(c.ctx, u.String(), c.header)
 	if err != nil {
-		return fmt.Errorf("failed to connect to websocket: %w", err)
+		return nil, fmt.Errorf("failed to connect to websocket: %w", err)
 	}
 
-	// Close existing connection if any
-	if c.conn != nil {
-		_ = c.conn.Close() // Best effort cleanup
+	c.logger.Info("Websocket connected",
+		logging.KeyChannel, channel,
+		logging.KeyEndpoint, u.String(),
+		logging.KeyLatency, time.Since(start))
+	return conn, nil
+}
+
+// connectToChannel establishes a websocket connection to a specific channel
+// An existing connection is replaced, together with its subscriptions.
+// Based on: clob-client-main/examples/socketConnection.ts:32
+func (c *Client) connectToChannel(channel string) error {
+	c.setState(StateConnecting)
+
+	conn, err := c.dial(channel)
+	if err != nil {
+		return err
 	}
 
+	c.mu.Lock()
+	if c.ctx.Err() != nil {
+		c.mu.Unlock()
+		_ = conn.Close()
+		return fmt.Errorf("client is closed")
+	}
+	old := c.conn
 	c.conn = conn
+	c.channel = channel
+	c.subscriptions = newSubscriptionSet()
 	c.isConnected = true
+	c.mu.Unlock()
 
-	// Start the message handler goroutine
-	go c.messageLoop()
+	// Close existing connection if any
+	if old != nil {
+		_ = old.Close() // Best effort cleanup
+	}
 
-	// Start heartbeat
-	// Based on: clob-client-main/examples/socketConnection.ts:83-86
-	c.startHeartbeat()
+	// Start the message handler goroutine
+	go c.messageLoop(conn)
 
+	c.setState(StateConnected)
 	if c.handler != nil {
 		c.handler.OnConnect()
 	}
@@ -242,8 +390,12 @@ func (c *Client) SubscribeToUser(creds *types.ApiCreds, markets []string, initia
 }
 
 // subscribe sends a subscription message to the websocket
+// The subscription is added to the subscription set, which is replayed after a reconnect.
 // Based on: clob-client-main/examples/socketConnection.ts:45-64,75-81
 func (c *Client) subscribe(subType SubscriptionType, auth *AuthMessage, markets []string, assetIDs []string, initialDump bool) error {
+	c.subMu.Lock()
+	defer c.subMu.Unlock()
+
 	c.mu.RLock()
 	if !c.isConnected || c.conn == nil {
 		c.mu.RUnlock()
@@ -269,115 +421,313 @@ func (c *Client) subscribe(subType SubscriptionType, auth *AuthMessage, markets
 		subMsg.AssetsIDs = []string{}
 	}
 
-	// Send subscription message
-	// Based on: clob-client-main/examples/socketConnection.ts:75-81
+	if err := c.send(conn, subMsg); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	if c.conn == conn {
+		c.subscriptions.init(subType, auth)
+		c.subscriptions.add(c.subscriptions.assets, assetIDs)
+		c.subscriptions.add(c.subscriptions.markets, markets)
+	}
+	c.mu.Unlock()
+
+	c.logger.Debug("Subscribed",
+		logging.KeyChannel, subType,
+		"assets", len(assetIDs),
+		"markets", len(markets),
+		"initial_dump", initialDump)
+	return nil
+}
+
+// send writes a subscription message to a connection
+// Based on: clob-client-main/examples/socketConnection.ts:75-81
+func (c *Client) send(conn *websocket.Conn, subMsg SubscriptionMessage) error {
 	msgBytes, err := json.Marshal(subMsg)
 	if err != nil {
 		return fmt.Errorf("failed to marshal subscription message: %w", err)
 	}
 
-	err = conn.WriteMessage(websocket.TextMessage, msgBytes)
-	if err != nil {
+	if err := c.writeMessage(conn, msgBytes); err != nil {
 		return fmt.Errorf("failed to send subscription message: %w", err)
 	}
-
-	log.Printf("Subscribed to %s with message: %s", subType, string(msgBytes))
 	return nil
 }
 
-// startHeartbeat starts the ping heartbeat
+// writeMessage writes a text message, serialized with all other writes
+func (c *Client) writeMessage(conn *websocket.Conn, data []byte) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	return conn.WriteMessage(websocket.TextMessage, data)
+}
+
+// startHeartbeat starts the ping heartbeat and the liveness checks of a connection and
+// returns a function stopping them
 // Based on: clob-client-main/examples/socketConnection.ts:83-86
-func (c *Client) startHeartbeat() {
-	c.pingTicker = time.NewTicker(30 * time.Second)
+func (c *Client) startHeartbeat(conn *websocket.Conn, stale *atomic.Bool) func() {
+	connectedAt := time.Now()
+	c.lastPing.Store(0)
+
+	ticker := time.NewTicker(c.liveness.PingInterval)
+	var checks <-chan time.Time
+	var checkTicker *time.Ticker
+	if interval := c.liveness.checkInterval(); interval > 0 {
+		checkTicker = time.NewTicker(interval)
+		checks = checkTicker.C
+	}
+
+	done := make(chan struct{})
 	go func() {
 		for {
 			select {
 			case <-c.ctx.Done():
 				return
-			case <-c.pingTicker.C:
-				c.mu.RLock()
-				if c.isConnected && c.conn != nil {
-					conn := c.conn
-					c.mu.RUnlock()
-					// Based on: clob-client-main/examples/socketConnection.ts:85
-					err := conn.WriteMessage(websocket.TextMessage, []byte("PING"))
-					if err != nil {
-						log.Printf("Failed to send ping: %v", err)
-						if c.handler != nil {
-							c.handler.OnError(err)
-						}
-					} else {
-						log.Printf("[WS PING] Sent PING to WebSocket")
+			case <-done:
+				return
+			case <-checks:
+				if err := c.checkLiveness(connectedAt); err != nil {
+					c.reportStale(conn, stale, err)
+					return
+				}
+			case <-ticker.C:
+				// Based on: clob-client-main/examples/socketConnection.ts:85
+				c.lastPing.Store(time.Now().UnixNano())
+				err := c.writeMessage(conn, []byte("PING"))
+				if err != nil {
+					c.logger.Warn("Failed to send ping", logging.KeyError, err)
+					if c.handler != nil {
+						c.handler.OnError(err)
 					}
 				} else {
-					c.mu.RUnlock()
+					c.logger.Debug("Sent PING")
 				}
 			}
 		}
 	}()
+
+	return func() {
+		ticker.Stop()
+		if checkTicker != nil {
+			checkTicker.Stop()
+		}
+		close(done)
+	}
 }
 
-// messageLoop handles incoming websocket messages
-// Based on: clob-client-main/examples/socketConnection.ts:93-95
-func (c *Client) messageLoop() {
-	defer func() {
-		c.mu.Lock()
+// messageLoop reads messages until the connection drops, then reconnects when enabled and
+// continues with the new connection
+// The backoff attempt count carries over connections that drop before they are stable.
+func (c *Client) messageLoop(conn *websocket.Conn) {
+	attempt := 0
+	for conn != nil {
+		connectedAt := time.Now()
+		c.readMessages(conn)
+		if !c.dropConnection(conn) {
+			return
+		}
+		if c.reconnect.stable(time.Since(connectedAt), c.liveness.PingInterval) {
+			attempt = 0
+		}
+		conn, attempt = c.reconnectLoop(attempt)
+	}
+}
+
+// dropConnection cleans up after a connection ended and reports whether to reconnect
+func (c *Client) dropConnection(conn *websocket.Conn) bool {
+	c.mu.Lock()
+	closed := c.ctx.Err() != nil
+	current := c.conn == conn
+	if current {
+		c.conn = nil
 		c.isConnected = false
-		if c.conn != nil {
-			_ = c.conn.Close() // Best effort cleanup
+	}
+	c.mu.Unlock()
+
+	_ = conn.Close() // Best effort cleanup
+
+	// A connection replaced by connectToChannel is not a disconnect
+	if !current && !closed {
+		return false
+	}
+
+	if c.handler != nil {
+		c.handler.OnDisconnect()
+	}
+
+	if closed || c.reconnect == nil {
+		c.setState(StateClosed)
+		return false
+	}
+	return true
+}
+
+// reconnectLoop reconnects with backoff and replays the subscriptions
+// The first delay is the backoff of the given attempt. It returns the new connection with
+// the attempt count to continue from, or nil when the client was closed, replaced or gave up.
+func (c *Client) reconnectLoop(attempt int) (*websocket.Conn, int) {
+	c.setState(StateReconnecting)
+
+	c.mu.RLock()
+	channel := c.channel
+	c.mu.RUnlock()
+
+	for failures := 0; c.reconnect.MaxAttempts == 0 || failures < c.reconnect.MaxAttempts; failures++ {
+		timer := time.NewTimer(c.reconnect.backoff(attempt))
+		attempt++
+		select {
+		case <-c.ctx.Done():
+			timer.Stop()
+			return nil, attempt
+		case <-timer.C:
+		}
+
+		conn, err := c.dial(channel)
+		if err != nil {
+			c.logger.Warn("Reconnect attempt failed",
+				logging.KeyChannel, channel,
+				"attempt", failures+1,
+				logging.KeyError, err)
+			continue
+		}
+
+		// Subscription changes wait until the new connection is published, so none is lost
+		// between the replay and the publication
+		c.subMu.Lock()
+		if err := c.resubscribe(conn); err != nil {
+			c.subMu.Unlock()
+			_ = conn.Close()
+			c.logger.Warn("Reconnect attempt failed",
+				logging.KeyChannel, channel,
+				"attempt", failures+1,
+				logging.KeyError, err)
+			continue
 		}
+
+		c.mu.Lock()
+		if c.ctx.Err() != nil || c.conn != nil {
+			// Closed or replaced by connectToChannel in the meantime
+			c.mu.Unlock()
+			c.subMu.Unlock()
+			_ = conn.Close()
+			return nil, attempt
+		}
+		c.conn = conn
+		c.isConnected = true
 		c.mu.Unlock()
+		c.subMu.Unlock()
 
+		c.setState(StateConnected)
 		if c.handler != nil {
-			c.handler.OnDisconnect()
+			c.handler.OnConnect()
 		}
-	}()
+		return conn, attempt
+	}
+
+	if c.handler != nil {
+		c.handler.OnError(fmt.Errorf("websocket reconnect gave up after %d attempts", c.reconnect.MaxAttempts))
+	}
+	c.setState(StateClosed)
+	return nil, attempt
+}
+
+// resubscribe replays the subscription set on a new connection, with an initial dump so
+// the state missed while disconnected is resent
+func (c *Client) resubscribe(conn *websocket.Conn) error {
+	c.mu.RLock()
+	subMsg, ok := c.subscriptions.message()
+	c.mu.RUnlock()
+
+	if !ok {
+		return nil
+	}
+
+	subMsg.InitialDump = true
+	if err := c.send(conn, subMsg); err != nil {
+		return err
+	}
+
+	c.logger.Info("Resubscribed after reconnect",
+		logging.KeyChannel, subMsg.Type,
+		"assets", len(subMsg.AssetsIDs),
+		"markets", len(subMsg.Markets))
+	return nil
+}
+
+// readMessages handles incoming websocket messages until the connection fails or the client
+// is closed
+// Based on: clob-client-main/examples/socketConnection.ts:93-95
+func (c *Client) readMessages(conn *websocket.Conn) {
+	// Start heartbeat
+	// Based on: clob-client-main/examples/socketConnection.ts:83-86
+	var stale atomic.Bool // Set once the liveness failure of the connection was reported
+	stopHeartbeat := c.startHeartbeat(conn, &stale)
+	defer stopHeartbeat()
+	c.extendReadDeadline(conn)
+
+	c.mu.RLock()
+	channel := c.channel
+	c.mu.RUnlock()
 
 	for {
 		select {
 		case <-c.ctx.Done():
 			return
 		default:
-			c.mu.RLock()
-			conn := c.conn
-			c.mu.RUnlock()
-
-			if conn == nil {
-				return
-			}
-
 			_, message, err := conn.ReadMessage()
 			if err != nil {
-				if c.handler != nil {
+				switch {
+				case stale.Load() || c.ctx.Err() != nil:
+					// Already reported, or closed
+				case isTimeout(err):
+					c.reportStale(conn, &stale, fmt.Errorf("%w: no message within read deadline %s", ErrPongTimeout, c.liveness.readTimeout()))
+				case c.handler != nil:
 					c.handler.OnError(fmt.Errorf("websocket read error: %w", err))
 				}
 				return
 			}
+			c.extendReadDeadline(conn)
 
-			// Handle PONG responses
-			if string(message) == "PONG" {
-				log.Printf("[WS PONG] Received PONG from WebSocket")
-				continue
-			}
-
-			// Try to parse as different message formats
-			// First try as an array (common for orderbook data)
-			var arrayUpdate []interface{}
-			if err := json.Unmarshal(message, &arrayUpdate); err == nil {
-				c.handleArrayMessage(arrayUpdate, message)
-				continue
+			if c.recorder != nil {
+				if err := c.recorder.Record(channel, time.Now(), message); err != nil {
+					c.logger.Warn("Failed to record message", logging.KeyError, err)
+				}
 			}
 
-			// Try to parse as object
-			var objectUpdate map[string]interface{}
-			if err := json.Unmarshal(message, &objectUpdate); err == nil {
-				c.handleObjectMessage(objectUpdate, message)
-				continue
-			}
+			c.handleFrame(message)
+		}
+	}
+}
 
-			log.Printf("Failed to parse message: %s", string(message))
+// handleFrame parses a received frame and dispatches it to the handler
+func (c *Client) handleFrame(message []byte) {
+	// Handle PONG responses
+	if string(message) == "PONG" {
+		c.lastPong.Store(time.Now().UnixNano())
+		if ping := c.lastPing.Load(); ping != 0 {
+			c.logger.Debug("Received PONG", logging.KeyLatency, time.Since(time.Unix(0, ping)))
 		}
+		return
+	}
+
+	c.lastMessage.Store(time.Now().UnixNano())
+
+	// Try to parse as different message formats
+	// First try as an array (common for orderbook data)
+	var arrayUpdate []interface{}
+	if err := json.Unmarshal(message, &arrayUpdate); err == nil {
+		c.handleArrayMessage(arrayUpdate, message)
+		return
+	}
+
+	// Try to parse as object
+	var objectUpdate map[string]interface{}
+	if err := json.Unmarshal(message, &objectUpdate); err == nil {
+		c.handleObjectMessage(objectUpdate, message)
+		return
 	}
+
+	c.logger.Warn("Failed to parse message", "message", string(message))
 }
 
 // handleArrayMessage processes array-format websocket messages
@@ -390,25 +740,12 @@ func (c *Client) handleArrayMessage(arrayData []interface{}, rawMessage []byte)
 	// For orderbook data, the array contains orderbook objects
 	for _, item := range arrayData {
 		if itemMap, ok := item.(map[string]interface{}); ok {
-			// Log the raw item for debugging
 			itemBytes, err := json.Marshal(itemMap)
 			if err != nil {
-				log.Printf("Failed to marshal array item: %v", err)
+				c.logger.Warn("Failed to marshal array item", logging.KeyError, err)
 				continue
 			}
-			
-			// Check what type of event this is
-			// if eventType, hasEventType := itemMap["event_type"].(string); hasEventType {
-			// 	log.Printf("Received %s event", eventType) // Disabled for TUI
-			// }
-			
-			// Log bid/ask counts for debugging
-			// if bids, hasBids := itemMap["bids"].([]interface{}); hasBids {
-			// 	if asks, hasAsks := itemMap["asks"].([]interface{}); hasAsks {
-			// 		log.Printf("Message contains %d bids, %d asks", len(bids), len(asks)) // Disabled for TUI
-			// 	}
-			// }
-			
+
 			c.handleObjectMessage(itemMap, itemBytes)
 		}
 	}
@@ -437,27 +774,26 @@ func (c *Client) handleObjectMessage(update map[string]interface{}, rawMessage [
 					bookUpdate.Sells = bookUpdate.Asks
 				}
 				
-				// Debug: Log if we have a slug field (temporary for debugging)
-				if bookUpdate.MarketSlug != "" || bookUpdate.Slug != "" {
-					log.Printf("DEBUG: Found slug in orderbook: market_slug='%s' slug='%s'", bookUpdate.MarketSlug, bookUpdate.Slug)
-				}
-				
-				// log.Printf("Parsed book message: %d buys, %d sells", len(bookUpdate.Buys), len(bookUpdate.Sells)) // Disabled for TUI
+				c.logger.Debug("Received book",
+					logging.KeyAssetID, bookUpdate.AssetID,
+					"market_slug", bookUpdate.MarketSlug,
+					"bids", len(bookUpdate.Buys),
+					"asks", len(bookUpdate.Sells))
 				c.handler.OnOrderBookUpdate(&bookUpdate)
 				return
 			} else {
-				log.Printf("Failed to parse book message: %v", err)
-				log.Printf("Raw book message: %s", string(rawMessage))
+				c.logger.Warn("Failed to parse book message", logging.KeyError, err, "message", string(rawMessage))
 			}
 			
 		case "price_change":
-			// Parse as price change message (incremental updates)
-			var priceUpdate PriceChangeUpdate
-			if err := json.Unmarshal(rawMessage, &priceUpdate); err == nil {
-				c.handler.OnPriceChange(&priceUpdate)
+			// Parse as price change message (incremental updates), one update per asset
+			if priceUpdates, err := parsePriceChanges(rawMessage); err == nil {
+				for _, priceUpdate := range priceUpdates {
+					c.handler.OnPriceChange(priceUpdate)
+				}
 				return
 			} else {
-				log.Printf("Failed to parse price_change message: %v", err)
+				c.logger.Warn("Failed to parse price_change message", logging.KeyError, err)
 			}
 			
 		case "tick_size_change":
@@ -467,7 +803,7 @@ func (c *Client) handleObjectMessage(update map[string]interface{}, rawMessage [
 				c.handler.OnTickSizeChange(&tickUpdate)
 				return
 			} else {
-				log.Printf("Failed to parse tick_size_change message: %v", err)
+				c.logger.Warn("Failed to parse tick_size_change message", logging.KeyError, err)
 			}
 			
 		case "last_trade_price":
@@ -477,11 +813,19 @@ func (c *Client) handleObjectMessage(update map[string]interface{}, rawMessage [
 				c.handler.OnLastTradePrice(&tradeUpdate)
 				return
 			} else {
-				log.Printf("Failed to parse last_trade_price message: %v", err)
+				c.logger.Warn("Failed to parse last_trade_price message", logging.KeyError, err)
 			}
 			
+		case "order", "trade":
+			// User channel messages
+			if err := c.handleUserEvent(eventType, update, rawMessage); err != nil {
+				c.logger.Warn("Failed to handle user message", logging.KeyError, err)
+				break
+			}
+			return
+
 		default:
-			log.Printf("Unknown event_type: %s", eventType)
+			c.logger.Debug("Unknown event type", "event_type", eventType)
 		}
 	}
 
@@ -505,7 +849,7 @@ func (c *Client) handleObjectMessage(update map[string]interface{}, rawMessage [
 				c.handler.OnOrderBookUpdate(bookUpdate)
 				return
 			} else {
-				log.Printf("Failed to parse legacy orderbook data: %v", err)
+				c.logger.Warn("Failed to parse legacy orderbook data", logging.KeyError, err)
 			}
 		}
 	}
@@ -515,26 +859,24 @@ func (c *Client) handleObjectMessage(update map[string]interface{}, rawMessage [
 	if err := json.Unmarshal(rawMessage, &userUpdate); err == nil {
 		c.handler.OnUserUpdate(&userUpdate)
 	} else {
-		log.Printf("Unknown message format: %s", string(rawMessage))
+		c.logger.Warn("Unknown message format", "message", string(rawMessage))
 	}
 }
 
-// Close closes the websocket connection
+// Close closes the websocket connection and stops reconnecting
 func (c *Client) Close() error {
 	c.cancel()
 
 	c.mu.Lock()
-	defer c.mu.Unlock()
+	conn := c.conn
+	c.conn = nil
+	c.isConnected = false
+	c.mu.Unlock()
 
-	if c.pingTicker != nil {
-		c.pingTicker.Stop()
-	}
+	c.setState(StateClosed)
 
-	if c.conn != nil {
-		err := c.conn.Close()
-		c.conn = nil
-		c.isConnected = false
-		return err
+	if conn != nil {
+		return conn.Close()
 	}
 
 	return nil
@@ -545,4 +887,4 @@ func (c *Client) IsConnected() bool {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
 	return c.isConnected
-}
\ No newline at end of file
+}
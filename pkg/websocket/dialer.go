@@ -0,0 +1,66 @@
+package websocket
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// DefaultHandshakeTimeout is the opening handshake timeout of the default dialer
+const DefaultHandshakeTimeout = 10 * time.Second
+
+// WithDialer returns a ClientOption that opens connections with a custom dialer, e.g. for
+// a proxy, a TLS configuration with a custom CA bundle, a bound source address (NetDialContext),
+// buffer sizes or permessage-deflate compression (EnableCompression)
+// A dialer without HandshakeTimeout uses DefaultHandshakeTimeout.
+func WithDialer(dialer *websocket.Dialer) ClientOption {
+	return func(c *Client) {
+		d := *dialer
+		if d.HandshakeTimeout == 0 {
+			d.HandshakeTimeout = DefaultHandshakeTimeout
+		}
+		c.dialer = &d
+	}
+}
+
+// WithHeaders returns a ClientOption that adds headers to the opening handshake
+func WithHeaders(header http.Header) ClientOption {
+	return func(c *Client) {
+		if c.header == nil {
+			c.header = make(http.Header)
+		}
+		for key, values := range header {
+			for _, value := range values {
+				c.header.Add(key, value)
+			}
+		}
+	}
+}
+
+// DialerFromTransport returns a dialer with the proxy, TLS configuration and connection
+// dialing of an HTTP transport, so streaming uses the same egress as REST requests
+func DialerFromTransport(transport *http.Transport) *websocket.Dialer {
+	dialer := &websocket.Dialer{
+		Proxy:             transport.Proxy,
+		NetDialContext:    transport.DialContext,
+		NetDialTLSContext: transport.DialTLSContext,
+		HandshakeTimeout:  DefaultHandshakeTimeout,
+	}
+	if transport.TLSClientConfig != nil {
+		dialer.TLSClientConfig = transport.TLSClientConfig.Clone()
+		// HTTP/2, possibly negotiated by the transport, cannot carry the websocket handshake
+		dialer.TLSClientConfig.NextProtos = nil
+	}
+	if transport.TLSHandshakeTimeout > dialer.HandshakeTimeout {
+		dialer.HandshakeTimeout = transport.TLSHandshakeTimeout
+	}
+	return dialer
+}
+
+// defaultDialer returns the dialer used without WithDialer
+func defaultDialer() *websocket.Dialer {
+	dialer := *websocket.DefaultDialer
+	dialer.HandshakeTimeout = DefaultHandshakeTimeout
+	return &dialer
+}
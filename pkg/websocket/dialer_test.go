@@ -0,0 +1,101 @@
+package websocket_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+
+	gorilla "github.com/gorilla/websocket"
+	"github.com/pooofdevelopment/go-clob-client/pkg/client"
+	"github.com/pooofdevelopment/go-clob-client/pkg/websocket"
+)
+
+// TestWebsocketDialer tests connecting with a custom CA, extra headers and compression
+func TestWebsocketDialer(t *testing.T) {
+	var mu sync.Mutex
+	var handshake http.Header
+	upgrader := gorilla.Upgrader{EnableCompression: true}
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		handshake = r.Header.Clone()
+		mu.Unlock()
+
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}))
+	defer server.Close()
+
+	// The test server certificate is not trusted by default
+	plain := websocket.NewClientWithOptions(server.URL, &websocket.BaseHandler{}, websocket.WithoutReconnect())
+	if err := plain.SubscribeToMarket([]string{"1234"}, true); err == nil {
+		t.Error("SubscribeToMarket() with an untrusted certificate should fail")
+	}
+	_ = plain.Close()
+
+	dialer := &gorilla.Dialer{
+		TLSClientConfig:   server.Client().Transport.(*http.Transport).TLSClientConfig,
+		EnableCompression: true,
+	}
+	c := websocket.NewClientWithOptions(server.URL, &websocket.BaseHandler{},
+		websocket.WithoutReconnect(),
+		websocket.WithDialer(dialer),
+		websocket.WithHeaders(http.Header{"X-Egress": {"desk-1"}}),
+	)
+	defer c.Close()
+	if err := c.SubscribeToMarket([]string{"1234"}, true); err != nil {
+		t.Fatalf("SubscribeToMarket() failed: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if got := handshake.Get("X-Egress"); got != "desk-1" {
+		t.Errorf("X-Egress = %q, want desk-1", got)
+	}
+	if got := handshake.Get("Sec-Websocket-Extensions"); !strings.Contains(got, "permessage-deflate") {
+		t.Errorf("Sec-WebSocket-Extensions = %q, want permessage-deflate", got)
+	}
+}
+
+// TestClientWebsocketTransport tests that websocket connections use the proxy of a custom HTTP transport
+func TestClientWebsocketTransport(t *testing.T) {
+	var mu sync.Mutex
+	var tunnels []string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		if r.Method == http.MethodConnect {
+			tunnels = append(tunnels, r.Host)
+		}
+		mu.Unlock()
+		http.Error(w, "egress denied", http.StatusForbidden)
+	}))
+	defer proxy.Close()
+
+	proxyURL, _ := url.Parse(proxy.URL)
+	httpClient := &http.Client{Transport: &http.Transport{Proxy: http.ProxyURL(proxyURL)}}
+	clobClient, err := client.NewClobClientWithOptions("https://clob.polymarket.com", 137, "", nil, nil, nil,
+		client.WithHTTPClient(httpClient))
+	if err != nil {
+		t.Fatalf("NewClobClientWithOptions() failed: %v", err)
+	}
+
+	if _, _, err := clobClient.SubscribeToMarketEvents([]string{"1234"}, websocket.StreamConfig{}); err == nil {
+		t.Fatal("SubscribeToMarketEvents() through a denying proxy should fail")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(tunnels) != 1 || tunnels[0] != "ws-subscriptions-clob.polymarket.com:443" {
+		t.Errorf("proxy tunnels = %v, want the websocket host", tunnels)
+	}
+}
@@ -0,0 +1,324 @@
+package websocket
+
+import (
+	"encoding/json"
+	"errors"
+	"sync"
+	"sync/atomic"
+)
+
+// EventType identifies the payload of an Event
+type EventType string
+
+const (
+	EventBook           EventType = "book"
+	EventPriceChange    EventType = "price_change"
+	EventTickSizeChange EventType = "tick_size_change"
+	EventLastTradePrice EventType = "last_trade_price"
+	EventUser           EventType = "user"
+	EventOrder          EventType = "order"
+	EventTrade          EventType = "trade"
+	EventError          EventType = "error"
+	EventConnect        EventType = "connect"
+	EventDisconnect     EventType = "disconnect"
+	EventState          EventType = "state"
+	EventStale          EventType = "stale"
+)
+
+// Event is a websocket message or connection event
+// Only the field matching Type is set.
+type Event struct {
+	Type EventType
+
+	Book           *OrderBookUpdate
+	PriceChange    *PriceChangeUpdate
+	TickSizeChange *TickSizeChangeUpdate
+	LastTradePrice *LastTradePriceUpdate
+	User           *UserUpdate
+	Order          *OrderEvent
+	Trade          *TradeEvent
+	Err            error // Set for EventError and EventStale
+	State          ConnectionState
+
+	// Shard is the connection of a Pool that received the event, 0 outside pools
+	Shard int
+}
+
+// OverflowPolicy decides what happens when the event buffer is full
+type OverflowPolicy int
+
+const (
+	// OverflowBlock blocks the reader until the consumer catches up, which applies
+	// backpressure to the connection
+	OverflowBlock OverflowPolicy = iota
+	// OverflowDropOldest discards the oldest buffered event to make room
+	OverflowDropOldest
+	// OverflowDisconnect closes the client; the stream ends and Err returns ErrEventOverflow
+	OverflowDisconnect
+)
+
+// DefaultEventBuffer is the event buffer size used when StreamConfig.Buffer is not set
+const DefaultEventBuffer = 1024
+
+// ErrEventOverflow is reported by EventStream.Err when the OverflowDisconnect policy closed the client
+var ErrEventOverflow = errors.New("websocket event buffer overflow")
+
+// StreamConfig configures an event stream
+type StreamConfig struct {
+	Buffer   int
+	Overflow OverflowPolicy
+}
+
+// EventStream is a MessageHandler delivering events on a buffered channel
+// The channel is closed when its client is closed.
+type EventStream struct {
+	events   chan Event
+	overflow OverflowPolicy
+	dropped  atomic.Uint64
+	err      atomic.Pointer[error]
+
+	mu       sync.RWMutex // Held for reading while sending, for writing while closing
+	closed   bool
+	done     chan struct{}
+	doneOnce sync.Once
+
+	// Closes the client on overflow with the OverflowDisconnect policy
+	disconnect func()
+}
+
+// NewEventStream creates an event stream
+func NewEventStream(config StreamConfig) *EventStream {
+	buffer := config.Buffer
+	if buffer <= 0 {
+		buffer = DefaultEventBuffer
+	}
+
+	return &EventStream{
+		events:   make(chan Event, buffer),
+		overflow: config.Overflow,
+		done:     make(chan struct{}),
+	}
+}
+
+// NewEventClient creates a websocket client delivering its events on a channel
+// Handlers run on the consumer's goroutine instead of the read goroutine.
+func NewEventClient(host string, config StreamConfig, opts ...ClientOption) (*Client, *EventStream) {
+	stream := NewEventStream(config)
+	c := NewClientWithOptions(host, stream, opts...)
+	c.stream = stream
+	stream.disconnect = func() { _ = c.Close() }
+	return c, stream
+}
+
+// Events returns the event channel
+func (s *EventStream) Events() <-chan Event {
+	return s.events
+}
+
+// Dropped returns the number of events discarded by the OverflowDropOldest policy
+func (s *EventStream) Dropped() uint64 {
+	return s.dropped.Load()
+}
+
+// Err returns ErrEventOverflow when the stream was ended by the OverflowDisconnect policy,
+// or the error that ended a replay, see ReplayEvents
+func (s *EventStream) Err() error {
+	if err := s.err.Load(); err != nil {
+		return *err
+	}
+	return nil
+}
+
+// send delivers an event according to the overflow policy
+func (s *EventStream) send(event Event) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.closed {
+		return
+	}
+
+	switch s.overflow {
+	case OverflowDropOldest:
+		for {
+			select {
+			case s.events <- event:
+				return
+			default:
+			}
+			select {
+			case <-s.events:
+				s.dropped.Add(1)
+			default:
+			}
+		}
+
+	case OverflowDisconnect:
+		select {
+		case s.events <- event:
+		default:
+			err := ErrEventOverflow
+			if s.err.CompareAndSwap(nil, &err) && s.disconnect != nil {
+				go s.disconnect()
+			}
+		}
+
+	default:
+		select {
+		case s.events <- event:
+		case <-s.done:
+		}
+	}
+}
+
+// offer delivers an event only when the buffer has room, whatever the overflow policy
+// It is used for events that must not block, e.g. the final state sent while closing.
+func (s *EventStream) offer(event Event) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.closed {
+		return
+	}
+	select {
+	case s.events <- event:
+	default:
+	}
+}
+
+// close ends the stream, unblocking pending sends first
+func (s *EventStream) close() {
+	s.doneOnce.Do(func() {
+		close(s.done)
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.closed = true
+		close(s.events)
+	})
+}
+
+// OnOrderBookUpdate implements MessageHandler
+func (s *EventStream) OnOrderBookUpdate(update *OrderBookUpdate) {
+	s.send(Event{Type: EventBook, Book: update})
+}
+
+// OnPriceChange implements MessageHandler
+func (s *EventStream) OnPriceChange(update *PriceChangeUpdate) {
+	s.send(Event{Type: EventPriceChange, PriceChange: update})
+}
+
+// OnTickSizeChange implements MessageHandler
+func (s *EventStream) OnTickSizeChange(update *TickSizeChangeUpdate) {
+	s.send(Event{Type: EventTickSizeChange, TickSizeChange: update})
+}
+
+// OnLastTradePrice implements MessageHandler
+func (s *EventStream) OnLastTradePrice(update *LastTradePriceUpdate) {
+	s.send(Event{Type: EventLastTradePrice, LastTradePrice: update})
+}
+
+// OnUserUpdate implements MessageHandler
+func (s *EventStream) OnUserUpdate(update *UserUpdate) {
+	s.send(Event{Type: EventUser, User: update})
+}
+
+// OnOrder implements UserEventHandler
+func (s *EventStream) OnOrder(event *OrderEvent) {
+	s.send(Event{Type: EventOrder, Order: event})
+}
+
+// OnTrade implements UserEventHandler
+func (s *EventStream) OnTrade(event *TradeEvent) {
+	s.send(Event{Type: EventTrade, Trade: event})
+}
+
+// OnStale implements LivenessHandler
+func (s *EventStream) OnStale(err error) {
+	s.send(Event{Type: EventStale, Err: err})
+}
+
+// OnError implements MessageHandler
+func (s *EventStream) OnError(err error) {
+	s.send(Event{Type: EventError, Err: err})
+}
+
+// OnConnect implements MessageHandler
+func (s *EventStream) OnConnect() {
+	s.send(Event{Type: EventConnect})
+}
+
+// OnDisconnect implements MessageHandler
+func (s *EventStream) OnDisconnect() {
+	s.send(Event{Type: EventDisconnect})
+}
+
+// Dispatch calls a MessageHandler for every event until the channel is closed
+// It adapts existing handlers to the event stream, running them off the read goroutine.
+// Order and trade events go to OnOrder and OnTrade when the handler implements
+// UserEventHandler, and to OnUserUpdate otherwise. Stale events go to OnStale when it
+// implements LivenessHandler, and to OnError otherwise.
+func Dispatch(events <-chan Event, handler MessageHandler) {
+	typed, _ := handler.(UserEventHandler)
+	liveness, _ := handler.(LivenessHandler)
+	for event := range events {
+		switch event.Type {
+		case EventBook:
+			handler.OnOrderBookUpdate(event.Book)
+		case EventPriceChange:
+			handler.OnPriceChange(event.PriceChange)
+		case EventTickSizeChange:
+			handler.OnTickSizeChange(event.TickSizeChange)
+		case EventLastTradePrice:
+			handler.OnLastTradePrice(event.LastTradePrice)
+		case EventUser:
+			handler.OnUserUpdate(event.User)
+		case EventOrder:
+			if typed != nil {
+				typed.OnOrder(event.Order)
+			} else {
+				handler.OnUserUpdate(newUserUpdate(eventData(event.Order)))
+			}
+		case EventTrade:
+			if typed != nil {
+				typed.OnTrade(event.Trade)
+			} else {
+				handler.OnUserUpdate(newUserUpdate(eventData(event.Trade)))
+			}
+		case EventError:
+			handler.OnError(event.Err)
+		case EventStale:
+			if liveness != nil {
+				liveness.OnStale(event.Err)
+			} else {
+				handler.OnError(event.Err)
+			}
+		case EventConnect:
+			handler.OnConnect()
+		case EventDisconnect:
+			handler.OnDisconnect()
+		}
+	}
+}
+
+// eventData converts a typed event back to the untyped message of a UserUpdate
+func eventData(event interface{}) map[string]interface{} {
+	var data map[string]interface{}
+	if raw, err := json.Marshal(event); err == nil {
+		_ = json.Unmarshal(raw, &data)
+	}
+	return data
+}
+
+// BaseHandler implements MessageHandler with no-op methods
+// Embed it to only implement the callbacks of interest.
+type BaseHandler struct{}
+
+func (BaseHandler) OnOrderBookUpdate(*OrderBookUpdate)     {}
+func (BaseHandler) OnPriceChange(*PriceChangeUpdate)       {}
+func (BaseHandler) OnTickSizeChange(*TickSizeChangeUpdate) {}
+func (BaseHandler) OnLastTradePrice(*LastTradePriceUpdate) {}
+func (BaseHandler) OnUserUpdate(*UserUpdate)               {}
+func (BaseHandler) OnError(error)                          {}
+func (BaseHandler) OnConnect()                             {}
+func (BaseHandler) OnDisconnect()                          {}
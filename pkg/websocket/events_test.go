@@ -0,0 +1,197 @@
+package websocket_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	gorilla "github.com/gorilla/websocket"
+	"github.com/pooofdevelopment/go-clob-client/pkg/websocket"
+)
+
+// priceChangeMessages returns n price_change messages with timestamps 0 to n-1
+func priceChangeMessages(n int) []string {
+	messages := make([]string, n)
+	for i := range messages {
+		messages[i] = fmt.Sprintf(`{"event_type":"price_change","asset_id":"1234","market":"0xm","timestamp":"%d","changes":[{"price":"0.5","side":"BUY","size":"10"}]}`, i)
+	}
+	return messages
+}
+
+// newFeedServer serves a websocket that sends messages after the subscription, then signals sent
+func newFeedServer(messages []string, sent chan<- struct{}) *httptest.Server {
+	upgrader := gorilla.Upgrader{}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		if _, _, err := conn.ReadMessage(); err != nil {
+			return
+		}
+		for _, msg := range messages {
+			if err := conn.WriteMessage(gorilla.TextMessage, []byte(msg)); err != nil {
+				return
+			}
+		}
+		if sent != nil {
+			close(sent)
+		}
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}))
+}
+
+// TestEventStream tests that events arrive in order and the channel closes with the client
+func TestEventStream(t *testing.T) {
+	server := newFeedServer(priceChangeMessages(3), nil)
+	defer server.Close()
+
+	client, stream := websocket.NewEventClient(server.URL, websocket.StreamConfig{Buffer: 16}, websocket.WithoutReconnect())
+	if err := client.SubscribeToMarket([]string{"1234"}, true); err != nil {
+		t.Fatalf("SubscribeToMarket() failed: %v", err)
+	}
+
+	var timestamps []string
+	var connected bool
+	timeout := time.After(5 * time.Second)
+	for len(timestamps) < 3 {
+		select {
+		case event := <-stream.Events():
+			switch event.Type {
+			case websocket.EventConnect:
+				connected = true
+			case websocket.EventPriceChange:
+				timestamps = append(timestamps, event.PriceChange.Timestamp)
+			}
+		case <-timeout:
+			t.Fatalf("received %v, want 3 price changes", timestamps)
+		}
+	}
+	if !connected || timestamps[0] != "0" || timestamps[2] != "2" {
+		t.Errorf("connected = %t, timestamps = %v", connected, timestamps)
+	}
+
+	client.Close()
+	var last websocket.Event
+	for event := range stream.Events() {
+		last = event
+	}
+	if last.Type != websocket.EventState || last.State != websocket.StateClosed {
+		t.Errorf("last event = %+v, want closed state", last)
+	}
+}
+
+// TestEventStreamDropOldest tests that a slow consumer keeps the newest events
+func TestEventStreamDropOldest(t *testing.T) {
+	sent := make(chan struct{})
+	server := newFeedServer(priceChangeMessages(50), sent)
+	defer server.Close()
+
+	client, stream := websocket.NewEventClient(server.URL, websocket.StreamConfig{Buffer: 4, Overflow: websocket.OverflowDropOldest}, websocket.WithoutReconnect())
+	defer client.Close()
+	if err := client.SubscribeToMarket([]string{"1234"}, true); err != nil {
+		t.Fatalf("SubscribeToMarket() failed: %v", err)
+	}
+
+	<-sent
+	deadline := time.After(5 * time.Second)
+	for {
+		select {
+		case event := <-stream.Events():
+			if event.Type == websocket.EventPriceChange && event.PriceChange.Timestamp == "49" {
+				if stream.Dropped() == 0 {
+					t.Error("Dropped() = 0, want dropped events")
+				}
+				return
+			}
+		case <-deadline:
+			t.Fatal("newest event not received")
+		}
+	}
+}
+
+// TestEventStreamDisconnect tests that an overflow closes the client with the disconnect policy
+func TestEventStreamDisconnect(t *testing.T) {
+	server := newFeedServer(priceChangeMessages(50), nil)
+	defer server.Close()
+
+	client, stream := websocket.NewEventClient(server.URL, websocket.StreamConfig{Buffer: 4, Overflow: websocket.OverflowDisconnect})
+	if err := client.SubscribeToMarket([]string{"1234"}, true); err != nil {
+		t.Fatalf("SubscribeToMarket() failed: %v", err)
+	}
+
+	// Only start consuming once the client gave up
+	deadline := time.Now().Add(5 * time.Second)
+	for client.State() != websocket.StateClosed {
+		if time.Now().After(deadline) {
+			t.Fatal("client not closed on overflow")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	for range stream.Events() {
+	}
+
+	if stream.Err() != websocket.ErrEventOverflow {
+		t.Errorf("Err() = %v, want ErrEventOverflow", stream.Err())
+	}
+}
+
+// TestEventStreamCloseUnread tests that Close returns while the consumer is not reading
+func TestEventStreamCloseUnread(t *testing.T) {
+	sent := make(chan struct{})
+	server := newFeedServer(priceChangeMessages(10), sent)
+	defer server.Close()
+
+	client, stream := websocket.NewEventClient(server.URL, websocket.StreamConfig{Buffer: 2}, websocket.WithoutReconnect())
+	if err := client.SubscribeToMarket([]string{"1234"}, true); err != nil {
+		t.Fatalf("SubscribeToMarket() failed: %v", err)
+	}
+	<-sent
+
+	closed := make(chan struct{})
+	go func() {
+		_ = client.Close()
+		close(closed)
+	}()
+	select {
+	case <-closed:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Close() blocked on the full event buffer")
+	}
+	for range stream.Events() {
+	}
+}
+
+// countingHandler counts price changes
+type countingHandler struct {
+	websocket.BaseHandler
+	priceChanges int32
+}
+
+func (h *countingHandler) OnPriceChange(*websocket.PriceChangeUpdate) {
+	atomic.AddInt32(&h.priceChanges, 1)
+}
+
+// TestDispatch tests running a MessageHandler from an event stream
+func TestDispatch(t *testing.T) {
+	events := make(chan websocket.Event, 3)
+	events <- websocket.Event{Type: websocket.EventPriceChange, PriceChange: &websocket.PriceChangeUpdate{}}
+	events <- websocket.Event{Type: websocket.EventConnect}
+	events <- websocket.Event{Type: websocket.EventPriceChange, PriceChange: &websocket.PriceChangeUpdate{}}
+	close(events)
+
+	handler := &countingHandler{}
+	websocket.Dispatch(events, handler)
+	if handler.priceChanges != 2 {
+		t.Errorf("price changes = %d, want 2", handler.priceChanges)
+	}
+}
@@ -0,0 +1,321 @@
+package websocket
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// HubFilter selects the events delivered to a hub subscriber; empty fields match everything
+// Tokens and Markets only apply to market data events; connection events (state, errors,
+// staleness) are delivered to every subscriber whose Types match. A subscriber that was
+// given tokens keeps filtering by them: once it removed the last one, it gets no market
+// data events.
+type HubFilter struct {
+	// Tokens are subscribed upstream for as long as the subscriber holds them
+	Tokens []string
+
+	Types   []EventType
+	Markets []string // Condition IDs
+}
+
+// HubConfig configures a hub
+type HubConfig struct {
+	// Pool configures the upstream connections
+	Pool PoolConfig
+}
+
+// Hub shares upstream market channel connections between the components of a process
+// Token subscriptions are reference counted: a token is subscribed upstream when its
+// first subscriber asks for it and unsubscribed when its last subscriber leaves.
+// Each subscriber has its own buffer and never blocks the others: OverflowBlock is
+// treated as OverflowDropOldest, and OverflowDisconnect only ends that subscriber.
+// Subscribers joining a token that is already subscribed only get the updates from then
+// on, not a book snapshot. A Hub is safe for concurrent use.
+type Hub struct {
+	pool *Pool
+
+	mu     sync.Mutex // Serializes subscription changes
+	refs   map[string]int
+	closed bool
+
+	// Held for writing while the subscribers or their tokens change, never across upstream
+	// calls, which may wait for the dispatch loop
+	subsMu sync.RWMutex
+	subs   map[*HubSubscriber]struct{}
+
+	done chan struct{} // Closed when the dispatch loop ends
+}
+
+// HubSubscriber is a filtered view of the events of a hub
+type HubSubscriber struct {
+	hub     *Hub
+	stream  *EventStream
+	types   map[EventType]struct{}
+	markets map[string]struct{}
+
+	tokens  map[string]struct{} // Guarded by hub.subsMu
+	byToken bool                // Filters by tokens, set once it held any; guarded by hub.subsMu
+	closed  bool                // Guarded by hub.mu
+}
+
+// NewHub creates a hub without subscriptions; opts are applied to every upstream connection
+func NewHub(host string, config HubConfig, opts ...ClientOption) *Hub {
+	h := &Hub{
+		pool: NewPool(host, config.Pool, opts...),
+		refs: make(map[string]int),
+		subs: make(map[*HubSubscriber]struct{}),
+		done: make(chan struct{}),
+	}
+	go h.dispatch()
+	return h
+}
+
+// Pool returns the upstream connections, e.g. for their Stats
+func (h *Hub) Pool() *Pool {
+	return h.pool
+}
+
+// Subscribe registers a subscriber and subscribes upstream to its tokens
+func (h *Hub) Subscribe(filter HubFilter, config StreamConfig) (*HubSubscriber, error) {
+	if config.Overflow == OverflowBlock {
+		config.Overflow = OverflowDropOldest
+	}
+
+	s := &HubSubscriber{
+		hub:     h,
+		stream:  NewEventStream(config),
+		types:   make(map[EventType]struct{}, len(filter.Types)),
+		markets: make(map[string]struct{}, len(filter.Markets)),
+		tokens:  make(map[string]struct{}),
+	}
+	s.stream.disconnect = func() { _ = s.Close() }
+	for _, t := range filter.Types {
+		s.types[t] = struct{}{}
+	}
+	for _, m := range filter.Markets {
+		s.markets[m] = struct{}{}
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		return nil, fmt.Errorf("hub is closed")
+	}
+	if err := h.acquire(s, filter.Tokens); err != nil {
+		return nil, err
+	}
+
+	h.subsMu.Lock()
+	h.subs[s] = struct{}{}
+	h.subsMu.Unlock()
+	return s, nil
+}
+
+// Tokens returns the upstream token subscriptions with their number of subscribers
+func (h *Hub) Tokens() map[string]int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	refs := make(map[string]int, len(h.refs))
+	for id, n := range h.refs {
+		refs[id] = n
+	}
+	return refs
+}
+
+// Close closes the upstream connections and every subscriber
+func (h *Hub) Close() error {
+	h.mu.Lock()
+	h.closed = true
+	h.mu.Unlock()
+
+	err := h.pool.Close()
+	<-h.done
+	return err
+}
+
+// acquire adds tokens to a subscriber, subscribing upstream to the ones without subscribers
+// On failure the tokens of this call are released again. Called with h.mu held.
+func (h *Hub) acquire(s *HubSubscriber, tokens []string) error {
+	var added, first []string
+	h.subsMu.Lock()
+	for _, id := range tokens {
+		if _, ok := s.tokens[id]; ok {
+			continue
+		}
+		s.tokens[id] = struct{}{}
+		s.byToken = true
+		added = append(added, id)
+		if h.refs[id]++; h.refs[id] == 1 {
+			first = append(first, id)
+		}
+	}
+	h.subsMu.Unlock()
+	if len(first) == 0 {
+		return nil
+	}
+
+	if err := h.pool.Subscribe(first...); err != nil {
+		return errors.Join(err, h.release(s, added))
+	}
+	return nil
+}
+
+// release removes tokens from a subscriber, unsubscribing upstream from the ones left
+// without subscribers. Called with h.mu held.
+func (h *Hub) release(s *HubSubscriber, tokens []string) error {
+	var last []string
+	h.subsMu.Lock()
+	for _, id := range tokens {
+		if _, ok := s.tokens[id]; !ok {
+			continue
+		}
+		delete(s.tokens, id)
+		if h.refs[id]--; h.refs[id] <= 0 {
+			delete(h.refs, id)
+			last = append(last, id)
+		}
+	}
+	h.subsMu.Unlock()
+	if len(last) == 0 || h.closed {
+		return nil
+	}
+	return h.pool.Unsubscribe(last...)
+}
+
+// dispatch delivers the upstream events to the matching subscribers until the pool is closed
+func (h *Hub) dispatch() {
+	defer close(h.done)
+
+	// Subscriber sends never block, see Hub
+	for event := range h.pool.Events() {
+		h.subsMu.RLock()
+		for s := range h.subs {
+			if s.matches(event) {
+				s.stream.send(event)
+			}
+		}
+		h.subsMu.RUnlock()
+	}
+
+	h.mu.Lock()
+	h.closed = true
+	h.mu.Unlock()
+
+	h.subsMu.Lock()
+	subs := h.subs
+	h.subs = make(map[*HubSubscriber]struct{})
+	h.subsMu.Unlock()
+
+	for s := range subs {
+		s.stream.close()
+	}
+}
+
+// Events returns the event channel, closed by Close or when the hub is closed
+func (s *HubSubscriber) Events() <-chan Event {
+	return s.stream.Events()
+}
+
+// Dropped returns the number of events discarded because the buffer was full
+func (s *HubSubscriber) Dropped() uint64 {
+	return s.stream.Dropped()
+}
+
+// Err returns ErrEventOverflow when the subscriber was ended by the OverflowDisconnect policy
+func (s *HubSubscriber) Err() error {
+	return s.stream.Err()
+}
+
+// Tokens returns the tokens held by the subscriber
+func (s *HubSubscriber) Tokens() []string {
+	s.hub.subsMu.RLock()
+	defer s.hub.subsMu.RUnlock()
+	return sortedKeys(s.tokens)
+}
+
+// AddTokens subscribes to more tokens
+func (s *HubSubscriber) AddTokens(tokens ...string) error {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+
+	if s.closed || s.hub.closed {
+		return fmt.Errorf("hub subscriber is closed")
+	}
+	return s.hub.acquire(s, tokens)
+}
+
+// RemoveTokens drops tokens, unsubscribing upstream from the ones left without subscribers
+func (s *HubSubscriber) RemoveTokens(tokens ...string) error {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+
+	if s.closed {
+		return nil
+	}
+	return s.hub.release(s, tokens)
+}
+
+// Close releases the tokens of the subscriber and closes its event channel
+func (s *HubSubscriber) Close() error {
+	s.hub.mu.Lock()
+	if s.closed {
+		s.hub.mu.Unlock()
+		return nil
+	}
+	s.closed = true
+
+	s.hub.subsMu.Lock()
+	delete(s.hub.subs, s)
+	tokens := sortedKeys(s.tokens)
+	s.hub.subsMu.Unlock()
+
+	err := s.hub.release(s, tokens)
+	s.hub.mu.Unlock()
+
+	s.stream.close()
+	return err
+}
+
+// matches reports whether an event passes the filter of the subscriber
+// Called with hub.subsMu held.
+func (s *HubSubscriber) matches(event Event) bool {
+	if len(s.types) > 0 {
+		if _, ok := s.types[event.Type]; !ok {
+			return false
+		}
+	}
+
+	assetID, market, ok := eventAsset(event)
+	if !ok {
+		return true
+	}
+	if s.byToken {
+		if _, ok := s.tokens[assetID]; !ok {
+			return false
+		}
+	}
+	if len(s.markets) > 0 {
+		if _, ok := s.markets[market]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// eventAsset returns the asset and market of a market data event
+func eventAsset(event Event) (assetID, market string, ok bool) {
+	switch {
+	case event.Book != nil:
+		return event.Book.AssetID, event.Book.Market, true
+	case event.PriceChange != nil:
+		return event.PriceChange.AssetID, event.PriceChange.Market, true
+	case event.TickSizeChange != nil:
+		return event.TickSizeChange.AssetID, event.TickSizeChange.Market, true
+	case event.LastTradePrice != nil:
+		return event.LastTradePrice.AssetID, event.LastTradePrice.Market, true
+	}
+	return "", "", false
+}
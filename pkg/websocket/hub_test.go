@@ -0,0 +1,159 @@
+package websocket_test
+
+import (
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/pooofdevelopment/go-clob-client/pkg/websocket"
+)
+
+// receivePriceChanges waits for price changes of n distinct assets on a hub subscriber
+func receivePriceChanges(t *testing.T, sub *websocket.HubSubscriber, n int) map[string]bool {
+	t.Helper()
+	assets := make(map[string]bool)
+	timeout := time.After(5 * time.Second)
+	for len(assets) < n {
+		select {
+		case event, ok := <-sub.Events():
+			if !ok {
+				t.Fatalf("events closed after %v", assets)
+			}
+			if event.Type == websocket.EventPriceChange {
+				assets[event.PriceChange.AssetID] = true
+			}
+		case <-timeout:
+			t.Fatalf("received price changes for %v, want %d assets", assets, n)
+		}
+	}
+	return assets
+}
+
+// TestWebsocketHub tests reference counted subscriptions and filtered subscribers
+func TestWebsocketHub(t *testing.T) {
+	server := httptest.NewServer(&shardServer{})
+	defer server.Close()
+
+	hub := websocket.NewHub(server.URL, websocket.HubConfig{}, websocket.WithoutReconnect())
+	defer hub.Close()
+
+	// A slow consumer that is never read
+	recorder, err := hub.Subscribe(websocket.HubFilter{}, websocket.StreamConfig{Buffer: 1})
+	if err != nil {
+		t.Fatalf("Subscribe() failed: %v", err)
+	}
+	other, err := hub.Subscribe(websocket.HubFilter{Markets: []string{"0xother"}}, websocket.StreamConfig{})
+	if err != nil {
+		t.Fatalf("Subscribe() failed: %v", err)
+	}
+
+	quoting, err := hub.Subscribe(websocket.HubFilter{Tokens: []string{"a", "b"}}, websocket.StreamConfig{})
+	if err != nil {
+		t.Fatalf("Subscribe() failed: %v", err)
+	}
+	if got := receivePriceChanges(t, quoting, 2); !got["a"] || !got["b"] {
+		t.Errorf("quoting received %v, want a and b", got)
+	}
+
+	risk, err := hub.Subscribe(websocket.HubFilter{
+		Tokens: []string{"b", "c"},
+		Types:  []websocket.EventType{websocket.EventPriceChange},
+	}, websocket.StreamConfig{})
+	if err != nil {
+		t.Fatalf("Subscribe() failed: %v", err)
+	}
+	if got := receivePriceChanges(t, risk, 1); !got["c"] {
+		t.Errorf("risk received %v, want c", got)
+	}
+
+	if got, want := hub.Tokens(), map[string]int{"a": 1, "b": 2, "c": 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Tokens() = %v, want %v", got, want)
+	}
+
+	// b is kept for risk
+	if err := quoting.Close(); err != nil {
+		t.Fatalf("Close() failed: %v", err)
+	}
+	if got := hub.Pool().Assets(); !reflect.DeepEqual(got, []string{"b", "c"}) {
+		t.Errorf("upstream assets = %v, want b and c", got)
+	}
+	for range quoting.Events() {
+		// Buffered before the close, then closed
+	}
+
+	if err := risk.RemoveTokens("b", "c"); err != nil {
+		t.Fatalf("RemoveTokens() failed: %v", err)
+	}
+	if got := hub.Tokens(); len(got) != 0 {
+		t.Errorf("Tokens() = %v, want none", got)
+	}
+
+	// Filters
+	for event := range drain(risk.Events()) {
+		if event.Type != websocket.EventPriceChange {
+			t.Errorf("risk received a %s event", event.Type)
+		}
+	}
+	for event := range drain(other.Events()) {
+		if event.PriceChange != nil {
+			t.Errorf("other market subscriber received %+v", event.PriceChange)
+		}
+	}
+
+	// The slow consumer dropped events instead of stalling the others
+	if recorder.Dropped() == 0 {
+		t.Error("the slow subscriber should have dropped events")
+	}
+
+	_ = hub.Close()
+	if _, ok := <-risk.Events(); ok {
+		t.Error("hub Close() should close the subscribers")
+	}
+	if _, err := hub.Subscribe(websocket.HubFilter{}, websocket.StreamConfig{}); err == nil {
+		t.Error("Subscribe() on a closed hub should fail")
+	}
+}
+
+// drain returns the events buffered on a channel
+func drain(events <-chan websocket.Event) chan websocket.Event {
+	buffered := make(chan websocket.Event, len(events))
+	for len(events) > 0 {
+		buffered <- <-events
+	}
+	close(buffered)
+	return buffered
+}
+
+// TestWebsocketHubRemoveLastToken tests that a subscriber without tokens left gets no market data
+func TestWebsocketHubRemoveLastToken(t *testing.T) {
+	server := httptest.NewServer(&shardServer{})
+	defer server.Close()
+
+	hub := websocket.NewHub(server.URL, websocket.HubConfig{}, websocket.WithoutReconnect())
+	defer hub.Close()
+
+	first, err := hub.Subscribe(websocket.HubFilter{Tokens: []string{"a"}}, websocket.StreamConfig{})
+	if err != nil {
+		t.Fatalf("Subscribe() failed: %v", err)
+	}
+	receivePriceChanges(t, first, 1)
+	if err := first.RemoveTokens("a"); err != nil {
+		t.Fatalf("RemoveTokens() failed: %v", err)
+	}
+	for range drain(first.Events()) {
+	}
+
+	second, err := hub.Subscribe(websocket.HubFilter{Tokens: []string{"b"}}, websocket.StreamConfig{})
+	if err != nil {
+		t.Fatalf("Subscribe() failed: %v", err)
+	}
+	receivePriceChanges(t, second, 1)
+	time.Sleep(50 * time.Millisecond)
+
+	for event := range drain(first.Events()) {
+		if event.PriceChange != nil {
+			t.Errorf("subscriber without tokens received %+v", event.PriceChange)
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package websocket
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"sync/atomic"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/pooofdevelopment/go-clob-client/pkg/logging"
+)
+
+// ErrPongTimeout is reported when the server did not answer a PING in time
+var ErrPongTimeout = errors.New("websocket pong timeout")
+
+// ErrStaleData is reported when no message arrived for LivenessConfig.MaxSilence
+var ErrStaleData = errors.New("websocket data stale")
+
+// minLivenessCheck bounds how often the liveness of a connection is checked
+const minLivenessCheck = 10 * time.Millisecond
+
+// LivenessConfig configures the detection of dead connections
+// A connection failing a check is closed, which reconnects the client when enabled, and
+// the failure is reported as a stale-data event.
+type LivenessConfig struct {
+	// PingInterval is the time between two PINGs
+	PingInterval time.Duration
+
+	// PongTimeout is the time to wait for the PONG answering a PING, 0 disables the check
+	// It also sets the read deadline of the connection to PingInterval + PongTimeout.
+	PongTimeout time.Duration
+
+	// MaxSilence is the longest time without a message (PONGs excluded) before the data is
+	// considered stale, 0 disables the check
+	// A client serves one channel, so it is set per channel; quiet markets need a larger value.
+	MaxSilence time.Duration
+}
+
+// DefaultLivenessConfig returns the liveness settings used by NewClient
+// Based on: Polymarket CLOB WebSocket API documentation (PING every 10 seconds)
+func DefaultLivenessConfig() LivenessConfig {
+	return LivenessConfig{
+		PingInterval: 10 * time.Second,
+		PongTimeout:  10 * time.Second,
+		MaxSilence:   0,
+	}
+}
+
+// checkInterval returns how often the checks run, 0 when no check is enabled
+func (lc *LivenessConfig) checkInterval() time.Duration {
+	interval := time.Duration(0)
+	for _, d := range []time.Duration{lc.PongTimeout, lc.MaxSilence} {
+		if d > 0 && (interval == 0 || d < interval) {
+			interval = d
+		}
+	}
+	if interval == 0 {
+		return 0
+	}
+
+	interval /= 4
+	if interval < minLivenessCheck {
+		interval = minLivenessCheck
+	}
+	return interval
+}
+
+// readTimeout returns the read deadline after a message, 0 for none
+func (lc *LivenessConfig) readTimeout() time.Duration {
+	if lc.PongTimeout <= 0 {
+		return 0
+	}
+	return lc.PingInterval + lc.PongTimeout
+}
+
+// LivenessHandler receives stale-data events
+// A MessageHandler also implementing it gets liveness failures through OnStale; other
+// handlers receive them through OnError. The error wraps ErrPongTimeout or ErrStaleData.
+type LivenessHandler interface {
+	OnStale(err error)
+}
+
+// WithLiveness returns a ClientOption that sets the liveness settings
+func WithLiveness(config LivenessConfig) ClientOption {
+	return func(c *Client) {
+		if config.PingInterval <= 0 {
+			config.PingInterval = DefaultLivenessConfig().PingInterval
+		}
+		c.liveness = config
+	}
+}
+
+// LastMessage returns the time of the last message received, PONGs excluded
+func (c *Client) LastMessage() time.Time {
+	return unixNanoTime(c.lastMessage.Load())
+}
+
+// LastPong returns the time of the last PONG received
+func (c *Client) LastPong() time.Time {
+	return unixNanoTime(c.lastPong.Load())
+}
+
+// unixNanoTime converts a stored timestamp, the zero time when unset
+func unixNanoTime(ns int64) time.Time {
+	if ns == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, ns)
+}
+
+// checkLiveness checks a connection established at connectedAt
+func (c *Client) checkLiveness(connectedAt time.Time) error {
+	now := time.Now()
+
+	if timeout := c.liveness.PongTimeout; timeout > 0 {
+		lastPing, lastPong := c.lastPing.Load(), c.lastPong.Load()
+		if lastPing != 0 && lastPong < lastPing && now.Sub(time.Unix(0, lastPing)) > timeout {
+			return fmt.Errorf("%w: no PONG within %s of PING", ErrPongTimeout, timeout)
+		}
+	}
+
+	if maxSilence := c.liveness.MaxSilence; maxSilence > 0 {
+		last := c.LastMessage()
+		if last.Before(connectedAt) {
+			last = connectedAt
+		}
+		if silence := now.Sub(last); silence > maxSilence {
+			return fmt.Errorf("%w: no message for %s", ErrStaleData, silence.Round(time.Millisecond))
+		}
+	}
+
+	return nil
+}
+
+// extendReadDeadline pushes the read deadline of a connection after a message
+func (c *Client) extendReadDeadline(conn *websocket.Conn) {
+	if timeout := c.liveness.readTimeout(); timeout > 0 {
+		_ = conn.SetReadDeadline(time.Now().Add(timeout))
+	}
+}
+
+// reportStale reports a liveness failure once per connection and closes the connection,
+// which ends its read loop
+func (c *Client) reportStale(conn *websocket.Conn, stale *atomic.Bool, err error) {
+	if !stale.CompareAndSwap(false, true) {
+		return
+	}
+
+	c.logger.Warn("Websocket connection is stale, closing", logging.KeyError, err)
+	if h, ok := c.handler.(LivenessHandler); ok {
+		h.OnStale(err)
+	} else if c.handler != nil {
+		c.handler.OnError(err)
+	}
+	_ = conn.Close()
+}
+
+// isTimeout reports whether a read failed on its deadline
+func isTimeout(err error) bool {
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
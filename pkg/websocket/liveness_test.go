@@ -0,0 +1,136 @@
+package websocket_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	gorilla "github.com/gorilla/websocket"
+	"github.com/pooofdevelopment/go-clob-client/pkg/websocket"
+)
+
+// newLivenessServer serves a websocket that answers PINGs when pong is set and counts connections
+func newLivenessServer(pong bool, connections *atomic.Int32) *httptest.Server {
+	upgrader := gorilla.Upgrader{}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		connections.Add(1)
+
+		for {
+			_, msg, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			if pong && string(msg) == "PING" {
+				if err := conn.WriteMessage(gorilla.TextMessage, []byte("PONG")); err != nil {
+					return
+				}
+			}
+		}
+	}))
+}
+
+// waitStale waits for a stale event and returns its error
+func waitStale(t *testing.T, stream *websocket.EventStream) error {
+	t.Helper()
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case event := <-stream.Events():
+			if event.Type == websocket.EventStale {
+				return event.Err
+			}
+		case <-timeout:
+			t.Fatal("no stale event")
+		}
+	}
+}
+
+// TestWebsocketPongTimeout tests that a server not answering PINGs is detected and reconnected
+func TestWebsocketPongTimeout(t *testing.T) {
+	var connections atomic.Int32
+	server := newLivenessServer(false, &connections)
+	defer server.Close()
+
+	client, stream := websocket.NewEventClient(server.URL, websocket.StreamConfig{Buffer: 64},
+		fastReconnect(0),
+		websocket.WithLiveness(websocket.LivenessConfig{
+			PingInterval: 20 * time.Millisecond,
+			PongTimeout:  50 * time.Millisecond,
+		}))
+	defer client.Close()
+	if err := client.SubscribeToMarket([]string{"1234"}, true); err != nil {
+		t.Fatalf("SubscribeToMarket() failed: %v", err)
+	}
+
+	if err := waitStale(t, stream); !errors.Is(err, websocket.ErrPongTimeout) {
+		t.Errorf("stale error = %v, want ErrPongTimeout", err)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for connections.Load() < 2 {
+		if time.Now().After(deadline) {
+			t.Fatal("client did not reconnect after the pong timeout")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// TestWebsocketMaxSilence tests that a connection answering PINGs without data is reported
+// stale, and that PONGs keep a connection without MaxSilence alive
+func TestWebsocketMaxSilence(t *testing.T) {
+	var connections atomic.Int32
+	server := newLivenessServer(true, &connections)
+	defer server.Close()
+
+	// Healthy: PONGs arrive and no silence threshold is set
+	healthy, healthyStream := websocket.NewEventClient(server.URL, websocket.StreamConfig{Buffer: 64},
+		websocket.WithoutReconnect(),
+		websocket.WithLiveness(websocket.LivenessConfig{
+			PingInterval: 20 * time.Millisecond,
+			PongTimeout:  50 * time.Millisecond,
+		}))
+	if err := healthy.SubscribeToMarket([]string{"1234"}, true); err != nil {
+		t.Fatalf("SubscribeToMarket() failed: %v", err)
+	}
+	time.Sleep(200 * time.Millisecond)
+	if pong := healthy.LastPong(); pong.IsZero() || time.Since(pong) > 100*time.Millisecond {
+		t.Errorf("LastPong() = %v, want a recent PONG", pong)
+	}
+	if state := healthy.State(); state != websocket.StateConnected {
+		t.Errorf("State() = %s, want connected", state)
+	}
+	_ = healthy.Close()
+	for event := range healthyStream.Events() {
+		if event.Type == websocket.EventStale {
+			t.Errorf("healthy connection reported stale: %v", event.Err)
+		}
+	}
+
+	// Silent: PONGs arrive but no data for longer than MaxSilence
+	silent, stream := websocket.NewEventClient(server.URL, websocket.StreamConfig{Buffer: 64},
+		websocket.WithoutReconnect(),
+		websocket.WithLiveness(websocket.LivenessConfig{
+			PingInterval: 20 * time.Millisecond,
+			PongTimeout:  50 * time.Millisecond,
+			MaxSilence:   100 * time.Millisecond,
+		}))
+	defer silent.Close()
+	if err := silent.SubscribeToMarket([]string{"1234"}, true); err != nil {
+		t.Fatalf("SubscribeToMarket() failed: %v", err)
+	}
+
+	if err := waitStale(t, stream); !errors.Is(err, websocket.ErrStaleData) {
+		t.Errorf("stale error = %v, want ErrStaleData", err)
+	}
+	if !silent.LastMessage().IsZero() {
+		t.Errorf("LastMessage() = %v, want zero without data", silent.LastMessage())
+	}
+}
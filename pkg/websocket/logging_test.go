@@ -0,0 +1,86 @@
+package websocket_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"log"
+	"log/slog"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pooofdevelopment/go-clob-client/pkg/websocket"
+)
+
+// logBuffer collects JSON log records written from several goroutines
+type logBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *logBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+// records returns the decoded records with the given message
+func (b *logBuffer) records(msg string) []map[string]interface{} {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var records []map[string]interface{}
+	for _, line := range strings.Split(strings.TrimSpace(b.buf.String()), "\n") {
+		var record map[string]interface{}
+		if json.Unmarshal([]byte(line), &record) == nil && record["msg"] == msg {
+			records = append(records, record)
+		}
+	}
+	return records
+}
+
+func newTestLogger() (*slog.Logger, *logBuffer) {
+	buf := &logBuffer{}
+	return slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug})), buf
+}
+
+// TestWebsocketLogger tests that the websocket client logs through its logger and is silent
+// by default
+func TestWebsocketLogger(t *testing.T) {
+	var std bytes.Buffer
+	defer log.SetOutput(log.Writer())
+	log.SetOutput(&std)
+
+	logger, buf := newTestLogger()
+	for _, opts := range [][]websocket.ClientOption{
+		{websocket.WithoutReconnect(), websocket.WithLogger(logger)},
+		{websocket.WithoutReconnect()},
+	} {
+		sent := make(chan struct{})
+		server := newFeedServer(priceChangeMessages(1), sent)
+
+		ws := websocket.NewClientWithOptions(server.URL, &countingHandler{}, opts...)
+		if err := ws.SubscribeToMarket([]string{"1234"}, true); err != nil {
+			t.Fatalf("SubscribeToMarket() failed: %v", err)
+		}
+		select {
+		case <-sent:
+		case <-time.After(5 * time.Second):
+			t.Fatal("feed was not sent")
+		}
+		_ = ws.Close()
+		server.Close()
+	}
+
+	connected := buf.records("Websocket connected")
+	if len(connected) != 1 || connected[0]["channel"] != "market" || connected[0]["endpoint"] == nil {
+		t.Errorf("connect records = %v", connected)
+	}
+	if subscribed := buf.records("Subscribed"); len(subscribed) != 1 || subscribed[0]["assets"] != float64(1) {
+		t.Errorf("subscribe records = %v", subscribed)
+	}
+	if std.Len() != 0 {
+		t.Errorf("default client wrote to the standard logger: %s", std.String())
+	}
+}
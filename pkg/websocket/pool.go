@@ -0,0 +1,432 @@
+package websocket
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// DefaultShardSize is the number of asset IDs per connection used when PoolConfig.ShardSize is not set
+const DefaultShardSize = 500
+
+// PoolConfig configures a sharded connection pool
+type PoolConfig struct {
+	// ShardSize is the maximum number of asset IDs per connection
+	ShardSize int
+
+	// MaxShards is the maximum number of connections, 0 for no limit
+	MaxShards int
+
+	// Stream configures the merged event stream
+	Stream StreamConfig
+}
+
+// ShardStats is the health of one connection of a pool
+type ShardStats struct {
+	Shard       int
+	State       ConnectionState
+	Assets      int
+	Messages    uint64 // Market data messages received
+	Reconnects  uint64
+	Stale       uint64 // Liveness failures, see LivenessConfig
+	Errors      uint64
+	LastMessage time.Time
+}
+
+// Pool spreads market channel subscriptions over several connections
+// Asset IDs go to the least loaded connection with room, a new connection is opened when all
+// are full, and connections left under-used by unsubscriptions are merged into the others.
+// Each connection reconnects on its own, and the events of all connections are merged into
+// one stream, with Event.Shard identifying the connection. State, connect and disconnect
+// events are dropped instead of waited for when the stream buffer is full. A Pool is safe for
+// concurrent use.
+type Pool struct {
+	host      string
+	shardSize int
+	maxShards int
+	opts      []ClientOption
+	stream    *EventStream
+
+	mu     sync.Mutex // Serializes subscription changes
+	shards []*shard
+	assets map[string]*shard
+	nextID int
+	closed bool
+}
+
+// shard is one connection of a pool
+type shard struct {
+	id     int
+	client *Client
+	assets map[string]struct{}
+
+	messages   atomic.Uint64
+	reconnects atomic.Uint64
+	stale      atomic.Uint64
+	errors     atomic.Uint64
+}
+
+// NewPool creates an empty connection pool; opts are applied to every connection
+// The state handler of the connections is used by the pool, WithStateHandler has no effect.
+func NewPool(host string, config PoolConfig, opts ...ClientOption) *Pool {
+	shardSize := config.ShardSize
+	if shardSize <= 0 {
+		shardSize = DefaultShardSize
+	}
+
+	p := &Pool{
+		host:      host,
+		shardSize: shardSize,
+		maxShards: config.MaxShards,
+		opts:      opts,
+		stream:    NewEventStream(config.Stream),
+		assets:    make(map[string]*shard),
+	}
+	p.stream.disconnect = func() { _ = p.Close() }
+	return p
+}
+
+// Events returns the merged event channel, closed by Close
+func (p *Pool) Events() <-chan Event {
+	return p.stream.Events()
+}
+
+// Stream returns the merged event stream
+func (p *Pool) Stream() *EventStream {
+	return p.stream
+}
+
+// Subscribe subscribes to asset IDs, skipping the ones already subscribed
+func (p *Pool) Subscribe(assetIDs ...string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.closed {
+		return fmt.Errorf("pool is closed")
+	}
+
+	pending := make([]string, 0, len(assetIDs))
+	seen := make(map[string]struct{}, len(assetIDs))
+	for _, id := range assetIDs {
+		if _, ok := p.assets[id]; ok {
+			continue
+		}
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		pending = append(pending, id)
+	}
+
+	// Fill the least loaded connections first
+	for len(pending) > 0 {
+		target := p.leastLoaded(nil)
+		if target == nil || len(target.assets) >= p.shardSize {
+			break
+		}
+
+		n := p.shardSize - len(target.assets)
+		if n > len(pending) {
+			n = len(pending)
+		}
+		if err := p.addToShard(target, pending[:n]); err != nil {
+			return err
+		}
+		pending = pending[n:]
+	}
+
+	// Open connections for the rest
+	for len(pending) > 0 {
+		if p.maxShards > 0 && len(p.shards) >= p.maxShards {
+			return fmt.Errorf("pool is full: %d connections of %d assets, %d assets left", len(p.shards), p.shardSize, len(pending))
+		}
+
+		n := p.shardSize
+		if n > len(pending) {
+			n = len(pending)
+		}
+		if err := p.openShard(pending[:n]); err != nil {
+			return err
+		}
+		pending = pending[n:]
+	}
+
+	return nil
+}
+
+// Unsubscribe unsubscribes from asset IDs and rebalances the connections
+func (p *Pool) Unsubscribe(assetIDs ...string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.closed {
+		return fmt.Errorf("pool is closed")
+	}
+
+	byShard := make(map[*shard][]string)
+	seen := make(map[string]struct{}, len(assetIDs))
+	for _, id := range assetIDs {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		if s, ok := p.assets[id]; ok {
+			byShard[s] = append(byShard[s], id)
+		}
+	}
+
+	// The assets stay subscribed when their connection fails to unsubscribe them
+	var errs []error
+	for s, ids := range byShard {
+		if len(ids) == len(s.assets) {
+			p.closeShard(s)
+		} else if err := s.client.RemoveAssets(ids...); err != nil {
+			errs = append(errs, fmt.Errorf("shard %d: %w", s.id, err))
+			continue
+		}
+		for _, id := range ids {
+			delete(p.assets, id)
+			delete(s.assets, id)
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return err
+	}
+
+	return p.rebalance()
+}
+
+// rebalance merges the least loaded connection into the others while they have room
+func (p *Pool) rebalance() error {
+	for len(p.shards) > 1 {
+		source := p.leastLoaded(nil)
+		room := 0
+		for _, s := range p.shards {
+			if s != source {
+				room += p.shardSize - len(s.assets)
+			}
+		}
+		if room < len(source.assets) {
+			return nil
+		}
+
+		// Subscribe on the other connections before dropping the source, so no update is missed
+		pending := sortedKeys(source.assets)
+		moved := make(map[*shard][]string)
+		for len(pending) > 0 {
+			target := p.leastLoaded(source)
+			n := p.shardSize - len(target.assets)
+			if n > len(pending) {
+				n = len(pending)
+			}
+			if err := p.addToShard(target, pending[:n]); err != nil {
+				return errors.Join(err, p.moveBack(source, moved))
+			}
+			moved[target] = append(moved[target], pending[:n]...)
+			pending = pending[n:]
+		}
+
+		source.assets = map[string]struct{}{}
+		p.closeShard(source)
+	}
+	return nil
+}
+
+// moveBack undoes a partial merge, unsubscribing the targets from the assets moved from source
+// An asset a target fails to unsubscribe stays on the target and is dropped from the source.
+func (p *Pool) moveBack(source *shard, moved map[*shard][]string) error {
+	var errs []error
+	for target, ids := range moved {
+		if err := target.client.RemoveAssets(ids...); err != nil {
+			errs = append(errs, fmt.Errorf("shard %d: %w", target.id, err))
+			for _, id := range ids {
+				delete(source.assets, id)
+			}
+			continue
+		}
+		for _, id := range ids {
+			delete(target.assets, id)
+			p.assets[id] = source
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// leastLoaded returns the connection with the fewest assets, other than exclude
+func (p *Pool) leastLoaded(exclude *shard) *shard {
+	var best *shard
+	for _, s := range p.shards {
+		if s != exclude && (best == nil || len(s.assets) < len(best.assets)) {
+			best = s
+		}
+	}
+	return best
+}
+
+// addToShard subscribes a connection to more assets
+func (p *Pool) addToShard(s *shard, ids []string) error {
+	if err := s.client.AddAssets(ids...); err != nil {
+		return fmt.Errorf("shard %d: %w", s.id, err)
+	}
+	for _, id := range ids {
+		s.assets[id] = struct{}{}
+		p.assets[id] = s
+	}
+	return nil
+}
+
+// openShard opens a connection subscribed to assets
+func (p *Pool) openShard(ids []string) error {
+	s := &shard{id: p.nextID, assets: make(map[string]struct{}, len(ids))}
+	p.nextID++
+
+	handler := &shardHandler{stream: p.stream, shard: s}
+	opts := append(append([]ClientOption{}, p.opts...), WithStateHandler(handler.onState))
+	s.client = NewClientWithOptions(p.host, handler, opts...)
+
+	if err := s.client.SubscribeToMarket(ids, true); err != nil {
+		_ = s.client.Close()
+		return fmt.Errorf("shard %d: %w", s.id, err)
+	}
+
+	for _, id := range ids {
+		s.assets[id] = struct{}{}
+		p.assets[id] = s
+	}
+	p.shards = append(p.shards, s)
+	return nil
+}
+
+// closeShard closes a connection and removes it from the pool
+func (p *Pool) closeShard(s *shard) {
+	for i, other := range p.shards {
+		if other == s {
+			p.shards = append(p.shards[:i], p.shards[i+1:]...)
+			break
+		}
+	}
+	_ = s.client.Close()
+}
+
+// Assets returns the subscribed asset IDs
+func (p *Pool) Assets() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	ids := make([]string, 0, len(p.assets))
+	for id := range p.assets {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// Stats returns the health of every connection, ordered by shard
+func (p *Pool) Stats() []ShardStats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	stats := make([]ShardStats, len(p.shards))
+	for i, s := range p.shards {
+		stats[i] = ShardStats{
+			Shard:       s.id,
+			State:       s.client.State(),
+			Assets:      len(s.assets),
+			Messages:    s.messages.Load(),
+			Reconnects:  s.reconnects.Load(),
+			Stale:       s.stale.Load(),
+			Errors:      s.errors.Load(),
+			LastMessage: s.client.LastMessage(),
+		}
+	}
+	sort.Slice(stats, func(i, j int) bool { return stats[i].Shard < stats[j].Shard })
+	return stats
+}
+
+// Close closes the merged stream and all connections
+// The stream is closed first so connections blocked on a full buffer are released.
+func (p *Pool) Close() error {
+	p.stream.close()
+
+	p.mu.Lock()
+	shards := p.shards
+	p.shards = nil
+	p.assets = make(map[string]*shard)
+	p.closed = true
+	p.mu.Unlock()
+
+	for _, s := range shards {
+		_ = s.client.Close()
+	}
+	return nil
+}
+
+// shardHandler forwards the events of one connection to the merged stream
+type shardHandler struct {
+	stream *EventStream
+	shard  *shard
+}
+
+func (h *shardHandler) send(event Event) {
+	event.Shard = h.shard.id
+	h.stream.send(event)
+}
+
+// offer forwards a connection lifecycle event without blocking, as they are reported while
+// the pool holds its lock to open and close connections; they are dropped when the buffer is full
+func (h *shardHandler) offer(event Event) {
+	event.Shard = h.shard.id
+	h.stream.offer(event)
+}
+
+func (h *shardHandler) onState(state ConnectionState) {
+	if state == StateReconnecting {
+		h.shard.reconnects.Add(1)
+	}
+	h.offer(Event{Type: EventState, State: state})
+}
+
+func (h *shardHandler) OnOrderBookUpdate(update *OrderBookUpdate) {
+	h.shard.messages.Add(1)
+	h.send(Event{Type: EventBook, Book: update})
+}
+
+func (h *shardHandler) OnPriceChange(update *PriceChangeUpdate) {
+	h.shard.messages.Add(1)
+	h.send(Event{Type: EventPriceChange, PriceChange: update})
+}
+
+func (h *shardHandler) OnTickSizeChange(update *TickSizeChangeUpdate) {
+	h.shard.messages.Add(1)
+	h.send(Event{Type: EventTickSizeChange, TickSizeChange: update})
+}
+
+func (h *shardHandler) OnLastTradePrice(update *LastTradePriceUpdate) {
+	h.shard.messages.Add(1)
+	h.send(Event{Type: EventLastTradePrice, LastTradePrice: update})
+}
+
+func (h *shardHandler) OnUserUpdate(update *UserUpdate) {
+	h.send(Event{Type: EventUser, User: update})
+}
+
+func (h *shardHandler) OnStale(err error) {
+	h.shard.stale.Add(1)
+	h.send(Event{Type: EventStale, Err: err})
+}
+
+func (h *shardHandler) OnError(err error) {
+	h.shard.errors.Add(1)
+	h.send(Event{Type: EventError, Err: err})
+}
+
+func (h *shardHandler) OnConnect() {
+	h.offer(Event{Type: EventConnect})
+}
+
+func (h *shardHandler) OnDisconnect() {
+	h.offer(Event{Type: EventDisconnect})
+}
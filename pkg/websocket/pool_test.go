@@ -0,0 +1,265 @@
+package websocket_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	gorilla "github.com/gorilla/websocket"
+	"github.com/pooofdevelopment/go-clob-client/pkg/websocket"
+)
+
+// shardServer is a market channel sending one price change per newly subscribed asset and
+// recording the unsubscriptions
+type shardServer struct {
+	mu           sync.Mutex
+	unsubscribed []string
+}
+
+func (f *shardServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	upgrader := gorilla.Upgrader{}
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	for {
+		_, msg, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+
+		var sub struct {
+			Operation string   `json:"operation"`
+			AssetsIDs []string `json:"assets_ids"`
+		}
+		if json.Unmarshal(msg, &sub) != nil {
+			continue
+		}
+		if sub.Operation == websocket.OperationUnsubscribe {
+			f.mu.Lock()
+			f.unsubscribed = append(f.unsubscribed, sub.AssetsIDs...)
+			f.mu.Unlock()
+			continue
+		}
+		for _, id := range sub.AssetsIDs {
+			update := fmt.Sprintf(`{"event_type":"price_change","asset_id":"%s","market":"0xm","timestamp":"1","changes":[]}`, id)
+			if err := conn.WriteMessage(gorilla.TextMessage, []byte(update)); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// collectPriceChanges waits for price changes of n distinct assets and returns their shards
+func collectPriceChanges(t *testing.T, pool *websocket.Pool, n int) map[string]int {
+	t.Helper()
+	shards := make(map[string]int)
+	timeout := time.After(5 * time.Second)
+	for len(shards) < n {
+		select {
+		case event := <-pool.Events():
+			if event.Type == websocket.EventPriceChange {
+				shards[event.PriceChange.AssetID] = event.Shard
+			}
+		case <-timeout:
+			t.Fatalf("received price changes for %v, want %d assets", shards, n)
+		}
+	}
+	return shards
+}
+
+// TestWebsocketPool tests sharding, rebalancing and merging of events
+func TestWebsocketPool(t *testing.T) {
+	fake := &shardServer{}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	pool := websocket.NewPool(server.URL, websocket.PoolConfig{ShardSize: 3}, websocket.WithoutReconnect())
+	defer pool.Close()
+
+	assets := []string{"a0", "a1", "a2", "a3", "a4", "a5", "a6"}
+	if err := pool.Subscribe(assets...); err != nil {
+		t.Fatalf("Subscribe() failed: %v", err)
+	}
+
+	stats := pool.Stats()
+	if len(stats) != 3 || stats[0].Assets != 3 || stats[1].Assets != 3 || stats[2].Assets != 1 {
+		t.Fatalf("Stats() = %+v, want shards of 3, 3 and 1 assets", stats)
+	}
+
+	shards := collectPriceChanges(t, pool, len(assets))
+	if shards["a0"] != 0 || shards["a3"] != 1 || shards["a6"] != 2 {
+		t.Errorf("event shards = %v", shards)
+	}
+
+	// Subscribing again is a no-op
+	if err := pool.Subscribe("a0"); err != nil || len(pool.Stats()) != 3 {
+		t.Errorf("Subscribe() of a known asset changed the pool: %v", err)
+	}
+
+	// Emptying the first shard closes it; the remaining shards cannot be merged yet
+	if err := pool.Unsubscribe("a0", "a1", "a2"); err != nil {
+		t.Fatalf("Unsubscribe() failed: %v", err)
+	}
+	if stats := pool.Stats(); len(stats) != 2 {
+		t.Fatalf("Stats() = %+v, want 2 shards", stats)
+	}
+
+	// Freeing room merges the last shard into the other one
+	if err := pool.Unsubscribe("a3"); err != nil {
+		t.Fatalf("Unsubscribe() failed: %v", err)
+	}
+	stats = pool.Stats()
+	if len(stats) != 1 || stats[0].Shard != 1 || stats[0].Assets != 3 {
+		t.Fatalf("Stats() = %+v, want shard 1 with 3 assets", stats)
+	}
+	if stats[0].State != websocket.StateConnected || stats[0].Messages == 0 {
+		t.Errorf("shard health = %+v", stats[0])
+	}
+	if got := pool.Assets(); len(got) != 3 || got[0] != "a4" || got[2] != "a6" {
+		t.Errorf("Assets() = %v, want a4 to a6", got)
+	}
+
+	// The merged asset moved with its events
+	shards = collectPriceChanges(t, pool, 1)
+	if shards["a6"] != 1 {
+		t.Errorf("a6 events on shard %v, want 1", shards)
+	}
+
+	// Sent before the subscription of a6, so received by now
+	fake.mu.Lock()
+	unsubscribed := fake.unsubscribed
+	fake.mu.Unlock()
+	if len(unsubscribed) != 1 || unsubscribed[0] != "a3" {
+		t.Errorf("unsubscribed = %v, want only a3 sent on a live shard", unsubscribed)
+	}
+
+	_ = pool.Close()
+	for range pool.Events() {
+	}
+}
+
+// TestWebsocketPoolLimit tests the connection limit
+func TestWebsocketPoolLimit(t *testing.T) {
+	server := httptest.NewServer(&shardServer{})
+	defer server.Close()
+
+	pool := websocket.NewPool(server.URL, websocket.PoolConfig{ShardSize: 2, MaxShards: 1}, websocket.WithoutReconnect())
+	defer pool.Close()
+
+	if err := pool.Subscribe("a0", "a1", "a2"); err == nil {
+		t.Error("Subscribe() beyond the limit should fail")
+	}
+	if got := pool.Assets(); len(got) != 2 {
+		t.Errorf("Assets() = %v, want the 2 that fit", got)
+	}
+}
+
+// TestWebsocketPoolCloseUnread tests that closing connections does not wait for the consumer
+func TestWebsocketPoolCloseUnread(t *testing.T) {
+	server := httptest.NewServer(&shardServer{})
+	defer server.Close()
+
+	pool := websocket.NewPool(server.URL, websocket.PoolConfig{ShardSize: 1, Stream: websocket.StreamConfig{Buffer: 1}}, websocket.WithoutReconnect())
+	if err := pool.Subscribe("a0", "a1", "a2"); err != nil {
+		t.Fatalf("Subscribe() failed: %v", err)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		if err := pool.Unsubscribe("a0"); err != nil {
+			t.Errorf("Unsubscribe() failed: %v", err)
+		}
+		_ = pool.Close()
+	}()
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Unsubscribe() or Close() blocked on the full event buffer")
+	}
+	for range pool.Events() {
+	}
+}
+
+// failingConn is a connection whose writes fail once fail is set
+type failingConn struct {
+	net.Conn
+	fail atomic.Bool
+}
+
+func (c *failingConn) Write(b []byte) (int, error) {
+	if c.fail.Load() {
+		return 0, errors.New("write failed")
+	}
+	return c.Conn.Write(b)
+}
+
+// TestWebsocketPoolFailures tests that failed subscription changes leave the pool unchanged
+func TestWebsocketPoolFailures(t *testing.T) {
+	fake := &shardServer{}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	var mu sync.Mutex
+	var conns []*failingConn
+	dialer := &gorilla.Dialer{
+		NetDial: func(network, addr string) (net.Conn, error) {
+			conn, err := net.Dial(network, addr)
+			if err != nil {
+				return nil, err
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			fc := &failingConn{Conn: conn}
+			conns = append(conns, fc)
+			return fc, nil
+		},
+	}
+
+	pool := websocket.NewPool(server.URL, websocket.PoolConfig{ShardSize: 3}, websocket.WithoutReconnect(), websocket.WithDialer(dialer))
+	defer pool.Close()
+
+	if err := pool.Subscribe("a0", "a1", "a2", "a3", "a4", "a5", "a6", "a7", "a8"); err != nil {
+		t.Fatalf("Subscribe() failed: %v", err)
+	}
+	if err := pool.Unsubscribe("a6"); err != nil {
+		t.Fatalf("Unsubscribe() failed: %v", err)
+	}
+	if err := pool.Unsubscribe("a3"); err != nil {
+		t.Fatalf("Unsubscribe() failed: %v", err)
+	}
+
+	// Merging the first shard moves a1 to the second one and fails on the third
+	mu.Lock()
+	conns[2].fail.Store(true)
+	mu.Unlock()
+	if err := pool.Unsubscribe("a0"); err == nil {
+		t.Fatal("Unsubscribe() with a failed merge should fail")
+	}
+	stats := pool.Stats()
+	if len(stats) != 3 || stats[0].Assets != 2 || stats[1].Assets != 2 || stats[2].Assets != 2 {
+		t.Fatalf("Stats() = %+v, want the merge undone", stats)
+	}
+	if got := pool.Assets(); strings.Join(got, ",") != "a1,a2,a4,a5,a7,a8" {
+		t.Errorf("Assets() = %v", got)
+	}
+
+	// The asset stays subscribed when its connection fails to unsubscribe it
+	if err := pool.Unsubscribe("a7"); err == nil {
+		t.Fatal("Unsubscribe() on a failing connection should fail")
+	}
+	if got := pool.Assets(); len(got) != 6 {
+		t.Errorf("Assets() = %v, want a7 kept", got)
+	}
+}
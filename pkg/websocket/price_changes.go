@@ -0,0 +1,64 @@
+package websocket
+
+import (
+	"encoding/json"
+)
+
+// batchedPriceChange is one entry of a batched price_change message
+// Based on: Polymarket CLOB WebSocket API documentation (market channel, "price_changes")
+type batchedPriceChange struct {
+	AssetID string `json:"asset_id"`
+	Price   string `json:"price"`
+	Size    string `json:"size"`
+	Side    string `json:"side"`
+	Hash    string `json:"hash"`
+	BestBid string `json:"best_bid"`
+	BestAsk string `json:"best_ask"`
+}
+
+// priceChangeMessage is a price_change message in either schema: one asset_id with a
+// changes array, or a price_changes array with the asset of each change
+type priceChangeMessage struct {
+	PriceChangeUpdate
+	PriceChanges []batchedPriceChange `json:"price_changes"`
+}
+
+// parsePriceChanges parses a price_change message into one update per asset
+// Batched changes are grouped by asset in message order; the hash of each update is the
+// hash of its last change.
+func parsePriceChanges(rawMessage []byte) ([]*PriceChangeUpdate, error) {
+	var message priceChangeMessage
+	if err := json.Unmarshal(rawMessage, &message); err != nil {
+		return nil, err
+	}
+	if len(message.PriceChanges) == 0 {
+		return []*PriceChangeUpdate{&message.PriceChangeUpdate}, nil
+	}
+
+	var updates []*PriceChangeUpdate
+	byAsset := make(map[string]*PriceChangeUpdate)
+	for _, change := range message.PriceChanges {
+		update, ok := byAsset[change.AssetID]
+		if !ok {
+			update = &PriceChangeUpdate{
+				EventType: message.EventType,
+				AssetID:   change.AssetID,
+				Market:    message.Market,
+				Timestamp: message.Timestamp,
+			}
+			byAsset[change.AssetID] = update
+			updates = append(updates, update)
+		}
+
+		update.Hash = change.Hash
+		update.Changes = append(update.Changes, PriceChange{
+			Price:   change.Price,
+			Side:    change.Side,
+			Size:    change.Size,
+			Hash:    change.Hash,
+			BestBid: change.BestBid,
+			BestAsk: change.BestAsk,
+		})
+	}
+	return updates, nil
+}
@@ -0,0 +1,138 @@
+package websocket_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pooofdevelopment/go-clob-client/pkg/orderbook"
+	"github.com/pooofdevelopment/go-clob-client/pkg/types"
+	"github.com/pooofdevelopment/go-clob-client/pkg/websocket"
+)
+
+var updateGolden = flag.Bool("update", false, "rewrite the golden files of the websocket schema tests")
+
+// TestWebsocketPriceChangeSchemas tests the parsing of every recorded price_change schema
+// against its golden updates
+// Inputs are raw frames in testdata/price_change, named <schema version>_<variant>.json;
+// when the market channel changes its schema, add a frame for the new version rather than
+// editing the existing ones. Run with -update to rewrite the .golden.json files.
+func TestWebsocketPriceChangeSchemas(t *testing.T) {
+	inputs, err := filepath.Glob(filepath.Join("testdata", "price_change", "v*.json"))
+	if err != nil || len(inputs) == 0 {
+		t.Fatalf("no price_change frames found: %v", err)
+	}
+
+	for _, input := range inputs {
+		if strings.HasSuffix(input, ".golden.json") {
+			continue
+		}
+		name := strings.TrimSuffix(filepath.Base(input), ".json")
+		t.Run(name, func(t *testing.T) {
+			frame, err := os.ReadFile(input)
+			if err != nil {
+				t.Fatalf("failed to read frame: %v", err)
+			}
+
+			got, err := json.MarshalIndent(parseFrame(t, bytes.TrimSpace(frame)), "", "  ")
+			if err != nil {
+				t.Fatalf("failed to marshal updates: %v", err)
+			}
+			got = append(got, '\n')
+
+			golden := strings.TrimSuffix(input, ".json") + ".golden.json"
+			if *updateGolden {
+				if err := os.WriteFile(golden, got, 0644); err != nil {
+					t.Fatalf("failed to write golden file: %v", err)
+				}
+			}
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatalf("failed to read golden file: %v", err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("updates of %s differ from %s:\n%s", input, golden, got)
+			}
+		})
+	}
+}
+
+// parseFrame feeds a frame through the client parsing and returns the price change updates
+func parseFrame(t *testing.T, frame []byte) []*websocket.PriceChangeUpdate {
+	t.Helper()
+	var capture bytes.Buffer
+	recorder := websocket.NewRecorder(&capture)
+	if err := recorder.Record("market", time.Now(), frame); err != nil {
+		t.Fatalf("Record() failed: %v", err)
+	}
+	if err := recorder.Close(); err != nil {
+		t.Fatalf("Close() failed: %v", err)
+	}
+
+	reader, err := websocket.NewCaptureReader(&capture)
+	if err != nil {
+		t.Fatalf("NewCaptureReader() failed: %v", err)
+	}
+	stream := websocket.ReplayEvents(context.Background(), reader, websocket.ReplayConfig{}, websocket.StreamConfig{})
+
+	var updates []*websocket.PriceChangeUpdate
+	for event := range stream.Events() {
+		switch event.Type {
+		case websocket.EventPriceChange:
+			updates = append(updates, event.PriceChange)
+		case websocket.EventError:
+			t.Errorf("replay error: %v", event.Err)
+		}
+	}
+	if err := stream.Err(); err != nil {
+		t.Fatalf("replay failed: %v", err)
+	}
+	return updates
+}
+
+// TestWebsocketBatchedPriceChanges tests that a batched message updates the book of each asset
+func TestWebsocketBatchedPriceChanges(t *testing.T) {
+	frame := `{"event_type":"price_change","market":"0xm","timestamp":"200","price_changes":[` +
+		`{"asset_id":"1234","price":"0.51","size":"5","side":"BUY","hash":"h1","best_bid":"0.51","best_ask":"0.52"},` +
+		`{"asset_id":"5678","price":"0.3","size":"1","side":"SELL","hash":"h2","best_bid":"0.2","best_ask":"0.3"},` +
+		`{"asset_id":"1234","price":"0.52","size":"0","side":"SELL","hash":"h3","best_bid":"0.51","best_ask":"0.55"}]}`
+
+	updates := parseFrame(t, []byte(frame))
+	if len(updates) != 2 || updates[0].AssetID != "1234" || updates[1].AssetID != "5678" {
+		t.Fatalf("updates = %+v, want one per asset in message order", updates)
+	}
+	if len(updates[0].Changes) != 2 || updates[0].Hash != "h3" || updates[0].Timestamp != "200" || updates[0].Market != "0xm" {
+		t.Errorf("update of 1234 = %+v", updates[0])
+	}
+
+	book, err := orderbook.NewOrderBook(orderbook.Config{TokenID: "1234", TickSize: types.TickSize("0.01")})
+	if err != nil {
+		t.Fatalf("NewOrderBook() failed: %v", err)
+	}
+	snapshot := &websocket.OrderBookUpdate{
+		EventType: "book",
+		AssetID:   "1234",
+		Market:    "0xm",
+		Timestamp: "100",
+		Buys:      []types.OrderSummary{{Price: "0.48", Size: "100"}, {Price: "0.5", Size: "10"}},
+		Sells:     []types.OrderSummary{{Price: "0.55", Size: "30"}, {Price: "0.52", Size: "15"}},
+	}
+	if err := book.ApplySnapshot(snapshot); err != nil {
+		t.Fatalf("ApplySnapshot() failed: %v", err)
+	}
+	if err := book.ApplyPriceChange(updates[0]); err != nil {
+		t.Fatalf("ApplyPriceChange() failed: %v", err)
+	}
+
+	bbo := book.BBO()
+	last := updates[0].Changes[1]
+	if bbo.Bid.Price.String() != last.BestBid || bbo.Ask.Price.String() != last.BestAsk {
+		t.Errorf("book top = %s x %s, want the sent %s x %s", bbo.Bid.Price, bbo.Ask.Price, last.BestBid, last.BestAsk)
+	}
+}
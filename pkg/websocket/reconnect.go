@@ -0,0 +1,97 @@
+package websocket
+
+import (
+	"math"
+	"math/rand"
+	"time"
+)
+
+// ConnectionState is the state of a websocket client connection
+type ConnectionState int
+
+const (
+	// StateConnecting is the state while the first connection is established
+	StateConnecting ConnectionState = iota
+	// StateConnected is the state while a connection is open and subscribed
+	StateConnected
+	// StateReconnecting is the state between a dropped connection and the next successful one
+	StateReconnecting
+	// StateClosed is the final state after Close or when reconnection gave up
+	StateClosed
+)
+
+// String returns the name of the state
+func (s ConnectionState) String() string {
+	switch s {
+	case StateConnecting:
+		return "connecting"
+	case StateConnected:
+		return "connected"
+	case StateReconnecting:
+		return "reconnecting"
+	case StateClosed:
+		return "closed"
+	default:
+		return "unknown"
+	}
+}
+
+// ReconnectConfig configures automatic reconnection after a dropped connection
+// The delay before attempt n (starting at 0) is InitialBackoff * Multiplier^n, capped at
+// MaxBackoff, and randomized by +/- Jitter (a fraction of the delay). The attempt count
+// carries over connections that drop before StableAfter, so a server that accepts and then
+// drops connections is redialled with growing delays.
+type ReconnectConfig struct {
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	Multiplier     float64
+	Jitter         float64
+
+	// MaxAttempts is the number of consecutive failed attempts before giving up, 0 for no limit
+	MaxAttempts int
+
+	// StableAfter is how long a connection has to stay up before the backoff starts again
+	// from InitialBackoff, 0 for the ping interval of the client
+	StableAfter time.Duration
+}
+
+// DefaultReconnectConfig returns the reconnection settings used by NewClient
+func DefaultReconnectConfig() ReconnectConfig {
+	return ReconnectConfig{
+		InitialBackoff: 500 * time.Millisecond,
+		MaxBackoff:     30 * time.Second,
+		Multiplier:     2,
+		Jitter:         0.2,
+		MaxAttempts:    0,
+	}
+}
+
+// stable reports whether a connection that was up for the given time resets the backoff
+func (rc *ReconnectConfig) stable(up time.Duration, pingInterval time.Duration) bool {
+	stableAfter := rc.StableAfter
+	if stableAfter <= 0 {
+		stableAfter = pingInterval
+	}
+	return up >= stableAfter
+}
+
+// backoff returns the delay before a reconnection attempt
+func (rc *ReconnectConfig) backoff(attempt int) time.Duration {
+	multiplier := rc.Multiplier
+	if multiplier < 1 {
+		multiplier = 1
+	}
+
+	delay := float64(rc.InitialBackoff) * math.Pow(multiplier, float64(attempt))
+	if rc.MaxBackoff > 0 && delay > float64(rc.MaxBackoff) {
+		delay = float64(rc.MaxBackoff)
+	}
+
+	if rc.Jitter > 0 {
+		delay += delay * rc.Jitter * (2*rand.Float64() - 1)
+	}
+	if delay < 0 {
+		delay = 0
+	}
+	return time.Duration(delay)
+}
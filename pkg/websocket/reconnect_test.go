@@ -0,0 +1,240 @@
+package websocket_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	gorilla "github.com/gorilla/websocket"
+	"github.com/pooofdevelopment/go-clob-client/pkg/websocket"
+)
+
+// stateRecorder records connection state changes
+type stateRecorder struct {
+	mu     sync.Mutex
+	states []websocket.ConnectionState
+	seen   chan websocket.ConnectionState
+}
+
+func newStateRecorder() *stateRecorder {
+	return &stateRecorder{seen: make(chan websocket.ConnectionState, 32)}
+}
+
+func (r *stateRecorder) record(state websocket.ConnectionState) {
+	r.mu.Lock()
+	r.states = append(r.states, state)
+	r.mu.Unlock()
+	r.seen <- state
+}
+
+// wait waits until the given state is reached
+func (r *stateRecorder) wait(t *testing.T, want websocket.ConnectionState) {
+	t.Helper()
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case state := <-r.seen:
+			if state == want {
+				return
+			}
+		case <-timeout:
+			t.Fatalf("state %s not reached", want)
+		}
+	}
+}
+
+// fastReconnect retries quickly so tests do not wait for the default backoff
+func fastReconnect(maxAttempts int) websocket.ClientOption {
+	return websocket.WithReconnect(websocket.ReconnectConfig{
+		InitialBackoff: 10 * time.Millisecond,
+		MaxBackoff:     50 * time.Millisecond,
+		Multiplier:     2,
+		Jitter:         0.2,
+		MaxAttempts:    maxAttempts,
+	})
+}
+
+// TestWebsocketReconnect tests that a dropped connection is re-established and resubscribed
+func TestWebsocketReconnect(t *testing.T) {
+	subscriptions := make(chan websocket.SubscriptionMessage, 8)
+	var mu sync.Mutex
+	connections := 0
+
+	upgrader := gorilla.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		mu.Lock()
+		connections++
+		first := connections == 1
+		mu.Unlock()
+
+		_, msg, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		var sub websocket.SubscriptionMessage
+		_ = json.Unmarshal(msg, &sub)
+		subscriptions <- sub
+
+		// Drop the first connection right after the subscription
+		if first {
+			return
+		}
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}))
+	defer server.Close()
+
+	states := newStateRecorder()
+	client := websocket.NewClientWithOptions(server.URL, nopHandler{}, fastReconnect(0), websocket.WithStateHandler(states.record))
+
+	if err := client.SubscribeToMarket([]string{"1234", "5678"}, false); err != nil {
+		t.Fatalf("SubscribeToMarket() failed: %v", err)
+	}
+
+	for i, wantDump := range []bool{false, true} {
+		select {
+		case sub := <-subscriptions:
+			if len(sub.AssetsIDs) != 2 || sub.Type != "market" || sub.InitialDump != wantDump {
+				t.Errorf("subscription %d = %+v, want both assets with initial dump %t", i, sub, wantDump)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("subscription %d not received", i)
+		}
+	}
+
+	states.wait(t, websocket.StateConnected)
+	if !client.IsConnected() {
+		t.Error("client should be connected after reconnect")
+	}
+
+	client.Close()
+	if client.State() != websocket.StateClosed {
+		t.Errorf("State() = %s, want closed", client.State())
+	}
+
+	states.mu.Lock()
+	defer states.mu.Unlock()
+	want := []websocket.ConnectionState{websocket.StateConnected, websocket.StateReconnecting, websocket.StateConnected, websocket.StateClosed}
+	if len(states.states) != len(want) {
+		t.Fatalf("states = %v, want %v", states.states, want)
+	}
+	for i := range want {
+		if states.states[i] != want[i] {
+			t.Errorf("states = %v, want %v", states.states, want)
+			break
+		}
+	}
+}
+
+// TestWebsocketReconnectGivesUp tests that reconnection stops after the maximum attempts
+func TestWebsocketReconnectGivesUp(t *testing.T) {
+	upgrader := gorilla.Upgrader{}
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		_, _, _ = conn.ReadMessage()
+		conn.Close()
+		// Refuse all further connections
+		go server.Close()
+	}))
+	defer server.Close()
+
+	states := newStateRecorder()
+	client := websocket.NewClientWithOptions(server.URL, nopHandler{}, fastReconnect(3), websocket.WithStateHandler(states.record))
+	defer client.Close()
+
+	if err := client.SubscribeToMarket([]string{"1234"}, true); err != nil {
+		t.Fatalf("SubscribeToMarket() failed: %v", err)
+	}
+
+	states.wait(t, websocket.StateReconnecting)
+	states.wait(t, websocket.StateClosed)
+	if client.IsConnected() {
+		t.Error("client should not be connected after giving up")
+	}
+}
+
+// TestWebsocketWithoutReconnect tests that a client without reconnection closes on disconnect
+func TestWebsocketWithoutReconnect(t *testing.T) {
+	upgrader := gorilla.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		_, _, _ = conn.ReadMessage()
+		conn.Close()
+	}))
+	defer server.Close()
+
+	states := newStateRecorder()
+	client := websocket.NewClientWithOptions(server.URL, nopHandler{}, websocket.WithoutReconnect(), websocket.WithStateHandler(states.record))
+	defer client.Close()
+
+	if err := client.SubscribeToMarket([]string{"1234"}, true); err != nil {
+		t.Fatalf("SubscribeToMarket() failed: %v", err)
+	}
+
+	states.wait(t, websocket.StateClosed)
+}
+
+// TestWebsocketReconnectBackoffCarriesOver tests that connections dropped right after they
+// are accepted are redialled with growing delays
+func TestWebsocketReconnectBackoffCarriesOver(t *testing.T) {
+	accepted := make(chan time.Time, 16)
+	upgrader := gorilla.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		_, _, _ = conn.ReadMessage()
+		select {
+		case accepted <- time.Now():
+		default:
+		}
+		conn.Close()
+	}))
+	defer server.Close()
+
+	client := websocket.NewClientWithOptions(server.URL, nopHandler{}, websocket.WithReconnect(websocket.ReconnectConfig{
+		InitialBackoff: 10 * time.Millisecond,
+		MaxBackoff:     time.Second,
+		Multiplier:     2,
+		StableAfter:    time.Minute,
+	}))
+	defer client.Close()
+
+	if err := client.SubscribeToMarket([]string{"1234"}, true); err != nil {
+		t.Fatalf("SubscribeToMarket() failed: %v", err)
+	}
+
+	// Delays of 10, 20, 40, 80 and 160ms between the 6 connections
+	var times []time.Time
+	for len(times) < 6 {
+		select {
+		case at := <-accepted:
+			times = append(times, at)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("%d connections accepted, want 6", len(times))
+		}
+	}
+	if gap := times[5].Sub(times[4]); gap < 100*time.Millisecond {
+		t.Errorf("delay before the 6th connection = %v, want the backoff to keep growing", gap)
+	}
+}
@@ -0,0 +1,108 @@
+package websocket_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	gorilla "github.com/gorilla/websocket"
+	"github.com/pooofdevelopment/go-clob-client/pkg/types"
+	"github.com/pooofdevelopment/go-clob-client/pkg/websocket"
+)
+
+// nopHandler is a websocket.MessageHandler ignoring all events
+type nopHandler struct{}
+
+func (nopHandler) OnOrderBookUpdate(*websocket.OrderBookUpdate)     {}
+func (nopHandler) OnPriceChange(*websocket.PriceChangeUpdate)       {}
+func (nopHandler) OnTickSizeChange(*websocket.TickSizeChangeUpdate) {}
+func (nopHandler) OnLastTradePrice(*websocket.LastTradePriceUpdate) {}
+func (nopHandler) OnUserUpdate(*websocket.UserUpdate)               {}
+func (nopHandler) OnError(error)                                    {}
+func (nopHandler) OnConnect()                                       {}
+func (nopHandler) OnDisconnect()                                    {}
+
+// syncBuffer is a bytes.Buffer safe for concurrent writes from the logger
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+// TestUserSubscriptionRedaction tests that the user subscription sends the real secrets but logs masked ones
+func TestUserSubscriptionRedaction(t *testing.T) {
+	received := make(chan []byte, 1)
+	upgrader := gorilla.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		_, msg, err := conn.ReadMessage()
+		if err == nil {
+			received <- msg
+		}
+		// Keep the connection open until the client closes it
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}))
+	defer server.Close()
+
+	logs := &syncBuffer{}
+	log.SetOutput(logs)
+	defer log.SetOutput(os.Stderr)
+
+	client := websocket.NewClient(server.URL, nopHandler{})
+	defer client.Close()
+
+	creds := &types.ApiCreds{ApiKey: "key", ApiSecret: "top-secret", ApiPassphrase: "hunter2"}
+	if err := client.SubscribeToUser(creds, []string{"0xmarket"}, true); err != nil {
+		t.Fatalf("SubscribeToUser() failed: %v", err)
+	}
+
+	select {
+	case msg := <-received:
+		var sub struct {
+			Auth struct {
+				APIKey     string `json:"apiKey"`
+				Secret     string `json:"secret"`
+				Passphrase string `json:"passphrase"`
+			} `json:"auth"`
+			Type string `json:"type"`
+		}
+		if err := json.Unmarshal(msg, &sub); err != nil {
+			t.Fatalf("invalid subscription message: %v", err)
+		}
+		if sub.Auth.Secret != "top-secret" || sub.Auth.Passphrase != "hunter2" || sub.Type != "user" {
+			t.Errorf("wire subscription = %s, want real credentials", msg)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("no subscription message received")
+	}
+
+	if strings.Contains(logs.String(), "top-secret") || strings.Contains(logs.String(), "hunter2") {
+		t.Errorf("logs leak secrets: %s", logs.String())
+	}
+}
@@ -0,0 +1,216 @@
+package websocket
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/pooofdevelopment/go-clob-client/pkg/logging"
+)
+
+// Subscription update operations
+const (
+	OperationSubscribe   = "subscribe"
+	OperationUnsubscribe = "unsubscribe"
+)
+
+// SubscriptionUpdateMessage adds or removes subscriptions on an open connection
+// Based on: Polymarket CLOB WebSocket API documentation
+type SubscriptionUpdateMessage struct {
+	Operation string   `json:"operation"`
+	Markets   []string `json:"markets,omitempty"`
+	AssetsIDs []string `json:"assets_ids,omitempty"`
+}
+
+// Subscriptions is a snapshot of the subscription set of a client
+type Subscriptions struct {
+	Channel  string
+	AssetIDs []string
+	Markets  []string
+}
+
+// subscriptionSet is the current subscriptions of a connection
+type subscriptionSet struct {
+	subscribed bool // The initial subscription message was sent
+	subType    SubscriptionType
+	auth       *AuthMessage
+	assets     map[string]struct{}
+	markets    map[string]struct{}
+}
+
+// newSubscriptionSet creates an empty subscription set
+func newSubscriptionSet() *subscriptionSet {
+	return &subscriptionSet{
+		assets:  make(map[string]struct{}),
+		markets: make(map[string]struct{}),
+	}
+}
+
+// init records the initial subscription of the connection
+func (s *subscriptionSet) init(subType SubscriptionType, auth *AuthMessage) {
+	s.subscribed = true
+	s.subType = subType
+	s.auth = auth
+}
+
+// add adds IDs to one of the sets and returns the ones that were not in it
+func (s *subscriptionSet) add(set map[string]struct{}, ids []string) []string {
+	var added []string
+	for _, id := range ids {
+		if _, ok := set[id]; !ok {
+			set[id] = struct{}{}
+			added = append(added, id)
+		}
+	}
+	return added
+}
+
+// remove removes IDs from one of the sets and returns the ones that were in it
+func (s *subscriptionSet) remove(set map[string]struct{}, ids []string) []string {
+	var removed []string
+	for _, id := range ids {
+		if _, ok := set[id]; ok {
+			delete(set, id)
+			removed = append(removed, id)
+		}
+	}
+	return removed
+}
+
+// message builds the subscription message for the whole set
+// It reports false before the initial subscription.
+func (s *subscriptionSet) message() (SubscriptionMessage, bool) {
+	if s == nil || !s.subscribed {
+		return SubscriptionMessage{}, false
+	}
+
+	return SubscriptionMessage{
+		Auth:      s.auth,
+		Type:      string(s.subType),
+		Markets:   sortedKeys(s.markets),
+		AssetsIDs: sortedKeys(s.assets),
+	}, true
+}
+
+// sortedKeys returns the keys of a set in sorted order
+func sortedKeys(set map[string]struct{}) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Subscriptions returns the current subscription set
+func (c *Client) Subscriptions() Subscriptions {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	subs := Subscriptions{Channel: c.channel, AssetIDs: []string{}, Markets: []string{}}
+	if c.subscriptions != nil {
+		subs.AssetIDs = sortedKeys(c.subscriptions.assets)
+		subs.Markets = sortedKeys(c.subscriptions.markets)
+	}
+	return subs
+}
+
+// AddAssets subscribes to more token IDs on the open market channel connection
+// While reconnecting the set is updated and applied by the replay.
+func (c *Client) AddAssets(assetIDs ...string) error {
+	return c.updateSubscriptions(SubscriptionTypeMarket, OperationSubscribe, assetIDs)
+}
+
+// RemoveAssets unsubscribes from token IDs on the open market channel connection
+func (c *Client) RemoveAssets(assetIDs ...string) error {
+	return c.updateSubscriptions(SubscriptionTypeMarket, OperationUnsubscribe, assetIDs)
+}
+
+// SetAssets replaces the subscribed token IDs of the market channel, sending only the
+// difference, e.g. to rotate through many markets on one connection
+func (c *Client) SetAssets(assetIDs []string) error {
+	want := make(map[string]struct{}, len(assetIDs))
+	for _, id := range assetIDs {
+		want[id] = struct{}{}
+	}
+
+	var stale []string
+	for _, id := range c.Subscriptions().AssetIDs {
+		if _, ok := want[id]; !ok {
+			stale = append(stale, id)
+		}
+	}
+
+	if err := c.RemoveAssets(stale...); err != nil {
+		return err
+	}
+	return c.AddAssets(assetIDs...)
+}
+
+// AddMarkets subscribes to more markets (condition IDs) on the open user channel connection
+func (c *Client) AddMarkets(markets ...string) error {
+	return c.updateSubscriptions(SubscriptionTypeUser, OperationSubscribe, markets)
+}
+
+// RemoveMarkets unsubscribes from markets on the open user channel connection
+func (c *Client) RemoveMarkets(markets ...string) error {
+	return c.updateSubscriptions(SubscriptionTypeUser, OperationUnsubscribe, markets)
+}
+
+// updateSubscriptions changes the subscription set and sends the change to the connection
+// IDs already in (or missing from) the set are skipped. When sending fails the set keeps the
+// change, which the replay applies after a reconnect.
+func (c *Client) updateSubscriptions(subType SubscriptionType, operation string, ids []string) error {
+	c.subMu.Lock()
+	defer c.subMu.Unlock()
+
+	c.mu.Lock()
+	if c.subscriptions == nil || !c.subscriptions.subscribed {
+		c.mu.Unlock()
+		return fmt.Errorf("client has no %s subscription", subType)
+	}
+	if c.subscriptions.subType != subType {
+		c.mu.Unlock()
+		return fmt.Errorf("client is subscribed to the %s channel, not %s", c.subscriptions.subType, subType)
+	}
+
+	set := c.subscriptions.assets
+	if subType == SubscriptionTypeUser {
+		set = c.subscriptions.markets
+	}
+	var changed []string
+	if operation == OperationSubscribe {
+		changed = c.subscriptions.add(set, ids)
+	} else {
+		changed = c.subscriptions.remove(set, ids)
+	}
+	conn := c.conn
+	connected := c.isConnected
+	c.mu.Unlock()
+
+	// Applied by the replay once reconnected
+	if len(changed) == 0 || !connected || conn == nil {
+		return nil
+	}
+
+	update := SubscriptionUpdateMessage{Operation: operation}
+	if subType == SubscriptionTypeUser {
+		update.Markets = changed
+	} else {
+		update.AssetsIDs = changed
+	}
+
+	msgBytes, err := json.Marshal(update)
+	if err != nil {
+		return fmt.Errorf("failed to marshal subscription update: %w", err)
+	}
+	if err := c.writeMessage(conn, msgBytes); err != nil {
+		return fmt.Errorf("failed to send subscription update: %w", err)
+	}
+
+	c.logger.Debug("Updated subscriptions",
+		logging.KeyChannel, subType,
+		"operation", operation,
+		"count", len(changed))
+	return nil
+}
@@ -0,0 +1,125 @@
+package websocket_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	gorilla "github.com/gorilla/websocket"
+	"github.com/pooofdevelopment/go-clob-client/pkg/websocket"
+)
+
+// wsMessage is a subscription or subscription update received by the test server
+type wsMessage struct {
+	Type        string   `json:"type"`
+	Operation   string   `json:"operation"`
+	AssetsIDs   []string `json:"assets_ids"`
+	InitialDump bool     `json:"initial_dump"`
+}
+
+// TestWebsocketDynamicSubscriptions tests adding and removing assets on a live connection
+func TestWebsocketDynamicSubscriptions(t *testing.T) {
+	messages := make(chan wsMessage, 16)
+	drop := make(chan struct{})
+	var connections int32
+
+	upgrader := gorilla.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		// Only the first connection is dropped
+		if atomic.AddInt32(&connections, 1) == 1 {
+			go func() {
+				<-drop
+				conn.Close()
+			}()
+		}
+		for {
+			_, data, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			var msg wsMessage
+			_ = json.Unmarshal(data, &msg)
+			select {
+			case messages <- msg:
+			default:
+			}
+		}
+	}))
+	defer server.Close()
+
+	states := newStateRecorder()
+	client := websocket.NewClientWithOptions(server.URL, nopHandler{}, fastReconnect(0), websocket.WithStateHandler(states.record))
+	defer client.Close()
+
+	next := func() wsMessage {
+		t.Helper()
+		select {
+		case msg := <-messages:
+			return msg
+		case <-time.After(5 * time.Second):
+			t.Fatal("no message received")
+			return wsMessage{}
+		}
+	}
+
+	if err := client.AddAssets("1"); err == nil {
+		t.Error("AddAssets() should fail before subscribing")
+	}
+
+	if err := client.SubscribeToMarket([]string{"a", "b"}, true); err != nil {
+		t.Fatalf("SubscribeToMarket() failed: %v", err)
+	}
+	if msg := next(); msg.Type != "market" || !reflect.DeepEqual(msg.AssetsIDs, []string{"a", "b"}) {
+		t.Errorf("initial subscription = %+v", msg)
+	}
+
+	steps := []struct {
+		name string
+		do   func() error
+		want []wsMessage
+	}{
+		{"add", func() error { return client.AddAssets("c", "a") }, []wsMessage{
+			{Operation: "subscribe", AssetsIDs: []string{"c"}},
+		}},
+		{"remove", func() error { return client.RemoveAssets("a", "x") }, []wsMessage{
+			{Operation: "unsubscribe", AssetsIDs: []string{"a"}},
+		}},
+		{"set", func() error { return client.SetAssets([]string{"c", "d"}) }, []wsMessage{
+			{Operation: "unsubscribe", AssetsIDs: []string{"b"}},
+			{Operation: "subscribe", AssetsIDs: []string{"d"}},
+		}},
+	}
+	for _, step := range steps {
+		if err := step.do(); err != nil {
+			t.Fatalf("%s failed: %v", step.name, err)
+		}
+		for _, want := range step.want {
+			if got := next(); !reflect.DeepEqual(got, want) {
+				t.Errorf("%s: message = %+v, want %+v", step.name, got, want)
+			}
+		}
+	}
+
+	if subs := client.Subscriptions(); subs.Channel != "market" || !reflect.DeepEqual(subs.AssetIDs, []string{"c", "d"}) {
+		t.Errorf("Subscriptions() = %+v, want market [c d]", subs)
+	}
+	if err := client.AddMarkets("0xmarket"); err == nil {
+		t.Error("AddMarkets() should fail on the market channel")
+	}
+
+	// The current set is replayed after a reconnect
+	close(drop)
+	if msg := next(); msg.Type != "market" || !msg.InitialDump || !reflect.DeepEqual(msg.AssetsIDs, []string{"c", "d"}) {
+		t.Errorf("replayed subscription = %+v, want [c d] with initial dump", msg)
+	}
+}
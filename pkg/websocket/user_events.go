@@ -0,0 +1,139 @@
+package websocket
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// Order event types
+const (
+	OrderEventPlacement    = "PLACEMENT"
+	OrderEventUpdate       = "UPDATE"
+	OrderEventCancellation = "CANCELLATION"
+)
+
+// Trade statuses, in settlement order; RETRYING and FAILED replace MINED and CONFIRMED
+// when the settlement transaction fails
+const (
+	TradeStatusMatched   = "MATCHED"
+	TradeStatusMined     = "MINED"
+	TradeStatusConfirmed = "CONFIRMED"
+	TradeStatusRetrying  = "RETRYING"
+	TradeStatusFailed    = "FAILED"
+)
+
+// OrderEvent is a user channel order message, sent when an order is placed, partially
+// matched (UPDATE) or cancelled
+// Based on: Polymarket CLOB WebSocket API documentation (user channel, event_type "order")
+type OrderEvent struct {
+	EventType       string   `json:"event_type"` // "order"
+	Type            string   `json:"type"`       // PLACEMENT, UPDATE or CANCELLATION
+	ID              string   `json:"id"`         // Order ID
+	Owner           string   `json:"owner"`      // API key of the event owner
+	OrderOwner      string   `json:"order_owner"`
+	Market          string   `json:"market"`
+	AssetID         string   `json:"asset_id"`
+	Side            string   `json:"side"`
+	Outcome         string   `json:"outcome"`
+	Price           string   `json:"price"`
+	OriginalSize    string   `json:"original_size"`
+	SizeMatched     string   `json:"size_matched"`
+	Status          string   `json:"status,omitempty"`
+	OrderType       string   `json:"order_type,omitempty"`
+	AssociateTrades []string `json:"associate_trades"`
+	Timestamp       string   `json:"timestamp"`
+}
+
+// MakerOrder is a resting order filled by a trade
+// Based on: Polymarket CLOB WebSocket API documentation (user channel, event_type "trade")
+type MakerOrder struct {
+	OrderID       string `json:"order_id"`
+	Owner         string `json:"owner"`
+	MakerAddress  string `json:"maker_address,omitempty"`
+	AssetID       string `json:"asset_id"`
+	Outcome       string `json:"outcome"`
+	Side          string `json:"side,omitempty"`
+	Price         string `json:"price"`
+	MatchedAmount string `json:"matched_amount"`
+	FeeRateBps    string `json:"fee_rate_bps,omitempty"`
+}
+
+// TradeEvent is a user channel trade message, sent when an order of the user is matched
+// and again on each settlement status change
+// Based on: Polymarket CLOB WebSocket API documentation (user channel, event_type "trade")
+type TradeEvent struct {
+	EventType       string       `json:"event_type"` // "trade"
+	Type            string       `json:"type"`       // "TRADE"
+	ID              string       `json:"id"`         // Trade ID
+	Status          string       `json:"status"`     // MATCHED, MINED, CONFIRMED, RETRYING or FAILED
+	Owner           string       `json:"owner"`
+	TradeOwner      string       `json:"trade_owner"`
+	Market          string       `json:"market"`
+	AssetID         string       `json:"asset_id"`
+	Side            string       `json:"side"`
+	Outcome         string       `json:"outcome"`
+	Price           string       `json:"price"` // Fill price
+	Size            string       `json:"size"`  // Fill size
+	FeeRateBps      string       `json:"fee_rate_bps,omitempty"`
+	TakerOrderID    string       `json:"taker_order_id"`
+	MakerOrders     []MakerOrder `json:"maker_orders"`
+	TransactionHash string       `json:"transaction_hash,omitempty"`
+	MatchTime       string       `json:"matchtime"`
+	LastUpdate      string       `json:"last_update"`
+	Timestamp       string       `json:"timestamp"`
+}
+
+// Final reports whether the trade reached a final status
+func (t *TradeEvent) Final() bool {
+	return t.Status == TradeStatusConfirmed || t.Status == TradeStatusFailed
+}
+
+// UserEventHandler receives typed user channel events
+// A MessageHandler also implementing it gets order and trade messages through these methods;
+// other handlers keep receiving them as untyped UserUpdate values.
+type UserEventHandler interface {
+	OnOrder(event *OrderEvent)
+	OnTrade(event *TradeEvent)
+}
+
+// handleUserEvent parses an order or trade message and dispatches it
+func (c *Client) handleUserEvent(eventType string, update map[string]interface{}, rawMessage []byte) error {
+	typed, ok := c.handler.(UserEventHandler)
+	if !ok {
+		c.handler.OnUserUpdate(newUserUpdate(update))
+		return nil
+	}
+
+	switch eventType {
+	case "order":
+		var event OrderEvent
+		if err := json.Unmarshal(rawMessage, &event); err != nil {
+			return fmt.Errorf("failed to parse order message: %w", err)
+		}
+		typed.OnOrder(&event)
+	case "trade":
+		var event TradeEvent
+		if err := json.Unmarshal(rawMessage, &event); err != nil {
+			return fmt.Errorf("failed to parse trade message: %w", err)
+		}
+		typed.OnTrade(&event)
+	}
+	return nil
+}
+
+// newUserUpdate wraps a user channel message for handlers without typed user events
+// Type, Data and Timestamp keep their meaning of the message's "type", "data" and
+// "timestamp" fields; the timestamp may be a number or a numeric string.
+func newUserUpdate(message map[string]interface{}) *UserUpdate {
+	userUpdate := &UserUpdate{Message: message}
+	userUpdate.Type, _ = message["type"].(string)
+	userUpdate.Data, _ = message["data"].(map[string]interface{})
+	switch ts := message["timestamp"].(type) {
+	case float64:
+		userUpdate.Timestamp = int64(ts)
+	case string:
+		userUpdate.Timestamp, _ = strconv.ParseInt(ts, 10, 64)
+	}
+	return userUpdate
+}
@@ -0,0 +1,112 @@
+package websocket_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pooofdevelopment/go-clob-client/pkg/types"
+	"github.com/pooofdevelopment/go-clob-client/pkg/websocket"
+)
+
+// userChannelMessages are an order placement followed by a matched trade
+var userChannelMessages = []string{
+	`{"event_type":"order","type":"PLACEMENT","id":"0xorder","owner":"key","order_owner":"key","market":"0xm","asset_id":"1234","side":"SELL","outcome":"YES","price":"0.57","original_size":"10","size_matched":"0","associate_trades":null,"timestamp":"1672290687"}`,
+	`{"event_type":"trade","type":"TRADE","id":"trade-1","status":"MATCHED","owner":"key","trade_owner":"key","market":"0xm","asset_id":"1234","side":"BUY","outcome":"YES","price":"0.57","size":"10","taker_order_id":"0xtaker","maker_orders":[{"order_id":"0xorder","owner":"key","asset_id":"1234","outcome":"YES","price":"0.57","matched_amount":"10"}],"transaction_hash":"0xtx","matchtime":"1672290701","last_update":"1672290701","timestamp":"1672290701"}`,
+}
+
+// untypedRecorder records UserUpdate values and does not implement UserEventHandler
+type untypedRecorder struct {
+	websocket.BaseHandler
+	mu      sync.Mutex
+	updates []*websocket.UserUpdate
+}
+
+func (h *untypedRecorder) OnUserUpdate(update *websocket.UserUpdate) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.updates = append(h.updates, update)
+}
+
+func (h *untypedRecorder) count() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return len(h.updates)
+}
+
+var testUserCreds = &types.ApiCreds{
+	ApiKey:        "key",
+	ApiSecret:     "c2VjcmV0LXNlY3JldC1zZWNyZXQ=",
+	ApiPassphrase: "passphrase",
+}
+
+// TestUserEvents tests that order and trade messages are delivered as typed events
+func TestUserEvents(t *testing.T) {
+	server := newFeedServer(userChannelMessages, nil)
+	defer server.Close()
+
+	client, stream := websocket.NewEventClient(server.URL, websocket.StreamConfig{Buffer: 16}, websocket.WithoutReconnect())
+	defer client.Close()
+	if err := client.SubscribeToUser(testUserCreds, []string{"0xm"}, true); err != nil {
+		t.Fatalf("SubscribeToUser() failed: %v", err)
+	}
+
+	var order *websocket.OrderEvent
+	var trade *websocket.TradeEvent
+	timeout := time.After(5 * time.Second)
+	for order == nil || trade == nil {
+		select {
+		case event := <-stream.Events():
+			switch event.Type {
+			case websocket.EventOrder:
+				order = event.Order
+			case websocket.EventTrade:
+				trade = event.Trade
+			case websocket.EventUser:
+				t.Errorf("received untyped user update %+v", event.User)
+			}
+		case <-timeout:
+			t.Fatalf("order = %v, trade = %v, want both", order, trade)
+		}
+	}
+
+	if order.Type != websocket.OrderEventPlacement || order.ID != "0xorder" || order.OriginalSize != "10" {
+		t.Errorf("order = %+v", order)
+	}
+	if trade.Status != websocket.TradeStatusMatched || trade.TransactionHash != "0xtx" || trade.Final() {
+		t.Errorf("trade = %+v", trade)
+	}
+	if len(trade.MakerOrders) != 1 || trade.MakerOrders[0].MatchedAmount != "10" || trade.MakerOrders[0].OrderID != "0xorder" {
+		t.Errorf("maker orders = %+v", trade.MakerOrders)
+	}
+}
+
+// TestUserEventsUntyped tests that handlers without OnOrder and OnTrade get UserUpdate values
+func TestUserEventsUntyped(t *testing.T) {
+	server := newFeedServer(userChannelMessages, nil)
+	defer server.Close()
+
+	handler := &untypedRecorder{}
+	client := websocket.NewClientWithOptions(server.URL, handler, websocket.WithoutReconnect())
+	defer client.Close()
+	if err := client.SubscribeToUser(testUserCreds, []string{"0xm"}, true); err != nil {
+		t.Fatalf("SubscribeToUser() failed: %v", err)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for handler.count() < 2 {
+		if time.Now().After(deadline) {
+			t.Fatalf("received %d user updates, want 2", handler.count())
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	handler.mu.Lock()
+	defer handler.mu.Unlock()
+	if handler.updates[0].Type != "PLACEMENT" || handler.updates[0].Data != nil || handler.updates[0].Message["id"] != "0xorder" {
+		t.Errorf("first update = %+v", handler.updates[0])
+	}
+	if handler.updates[1].Type != "TRADE" || handler.updates[1].Message["event_type"] != "trade" || handler.updates[1].Timestamp != 1672290701 {
+		t.Errorf("second update = %+v", handler.updates[1])
+	}
+}
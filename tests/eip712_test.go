@@ -11,7 +11,7 @@ import (
 func TestSignClobAuthMessage(t *testing.T) {
 	// Test with known values
 	testPrivateKey := "0xac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80"
-	expectedAddress := "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"
+	expectedAddress := "0xf39fd6e51aad88f6f4ce6ab8827279cfffb92266"
 
 	s, err := signer.NewSigner(testPrivateKey, 137)
 	if err != nil {
@@ -21,14 +21,14 @@ func TestNewSigner(t *testing.T) {
 			privateKey: "0xac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80",
 			chainID:    137,
 			wantErr:    false,
-			wantAddr:   "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266", // Known address for this test key
+			wantAddr:   "0xf39fd6e51aad88f6f4ce6ab8827279cfffb92266", // Known address for this test key, lowercased by Address()
 		},
 		{
 			name:       "valid private key without 0x prefix",
 			privateKey: "ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80",
 			chainID:    137,
 			wantErr:    false,
-			wantAddr:   "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266",
+			wantAddr:   "0xf39fd6e51aad88f6f4ce6ab8827279cfffb92266",
 		},
 		{
 			name:       "empty private key",